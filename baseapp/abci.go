@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
@@ -108,7 +109,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	if app.cms.TracingEnabled() {
 		app.cms.SetTracingContext(sdk.TraceContext(
-			map[string]interface{}{"blockHeight": req.Header.Height},
+			map[string]interface{}{sdk.TraceContextKeyBlockHeight: req.Header.Height},
 		))
 	}
 
@@ -139,6 +140,15 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(gasMeter)
 
+	// the deliver state of the first block is set by InitChain, before the
+	// height is known
+	if app.deliverState.ms.TracingEnabled() {
+		app.deliverState.ms = app.deliverState.ms.SetTracingContext(sdk.TraceContext(
+			map[string]interface{}{sdk.TraceContextKeyBlockHeight: req.Header.Height},
+		)).(sdk.CacheMultiStore)
+		app.deliverState.ctx = app.deliverState.ctx.WithMultiStore(app.deliverState.ms)
+	}
+
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
 	}
@@ -189,7 +199,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, err := app.runTx(mode, req.Tx, tx)
+	gInfo, result, err := app.runTx(app.getContextForTx(mode, req.Tx), mode, req.Tx, tx)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
@@ -226,12 +236,16 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx, tx)
+	gInfo, result, err := app.runTx(app.getContextForTx(runTxModeDeliver, req.Tx), runTxModeDeliver, req.Tx, tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
 
+	if feeTx, ok := tx.(sdk.FeeTx); ok && app.feeOracle != nil {
+		app.feeOracle.RecordTx(feeTx.GetFee(), feeTx.GetGas())
+	}
+
 	return abci.ResponseDeliverTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
@@ -269,6 +283,10 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	if app.feeOracle != nil {
+		app.feeOracle.Commit()
+	}
+
 	var halt bool
 
 	switch {
@@ -354,7 +372,11 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 		return sdkerrors.QueryResult(err)
 	}
 
-	res, err := handler(ctx, req)
+	var res abci.ResponseQuery
+	err = runQuery(ctx, func() (err error) {
+		res, err = handler(ctx, req)
+		return err
+	})
 	if err != nil {
 		res = sdkerrors.QueryResult(err)
 		res.Height = req.Height
@@ -389,12 +411,13 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
-	// cache wrap the commit-multistore for safety
+	// cache wrap the commit-multistore for safety, and bound the gas and time
+	// of the query
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.minGasPrices).WithGasMeter(app.newQueryGasMeter())
 
-	return ctx, nil
+	return ctx.WithContext(context.WithValue(ctx.Context(), queryHeightKey{}, height)), nil
 }
 
 func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
@@ -528,7 +551,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 	//
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets
 	// []string{"proposal", "test"} as the path.
-	resBytes, err := querier(ctx, path[2:], req)
+	var resBytes []byte
+	err = runQuery(ctx, func() (err error) {
+		resBytes, err = querier(ctx, path[2:], req)
+		return err
+	})
 	if err != nil {
 		res := sdkerrors.QueryResult(err)
 		res.Height = req.Height
@@ -3,7 +3,9 @@ package baseapp
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -15,6 +17,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/feeestimate"
+	"github.com/cosmos/cosmos-sdk/types/txtrace"
 )
 
 const (
@@ -53,6 +57,7 @@ type BaseApp struct { // nolint: maligned
 	txDecoder       sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
+	postHandler    sdk.PostHandler  // post handler, run after the messages of a tx
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -60,6 +65,11 @@ type BaseApp struct { // nolint: maligned
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	traceBlockChecker TraceBlockChecker // checks that the txs of a block can be traced without its BeginBlock
+
+	// databases of the stores held apart from the common DB, by store key name
+	storeDBs map[string]dbm.DB
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -90,6 +100,10 @@ type BaseApp struct { // nolint: maligned
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// gas limit and wall-clock timeout of each query, unbounded if zero
+	queryGasLimit uint64
+	queryTimeout  time.Duration
+
 	// application's version string
 	appVersion string
 
@@ -98,6 +112,9 @@ type BaseApp struct { // nolint: maligned
 
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
+
+	// node-local oracle sampling the gas prices of committed transactions
+	feeOracle *feeestimate.Oracle
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -209,9 +226,15 @@ func (app *BaseApp) MountStoreWithDB(key sdk.StoreKey, typ sdk.StoreType, db dbm
 }
 
 // MountStore mounts a store to the provided key in the BaseApp multistore,
-// using the default DB.
+// using the default DB unless another one was set for the key with the
+// SetStoreDBs option.
 func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
-	app.cms.MountStoreWithDB(key, typ, nil)
+	var db dbm.DB
+	if typ == sdk.StoreTypeIAVL || typ == sdk.StoreTypeDB {
+		db = app.storeDBs[key.Name()]
+	}
+
+	app.cms.MountStoreWithDB(key, typ, db)
 }
 
 // LoadLatestVersion loads the latest application version. It will panic if
@@ -251,6 +274,27 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// Close closes the databases of the stores held apart from the common DB, which
+// the app owns once set with the SetStoreDBs option. The common DB is owned and
+// closed by the caller.
+func (app *BaseApp) Close() error {
+	var firstErr error
+	for _, db := range app.storeDBs {
+		if err := db.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// CommitMultiStore returns the root multistore of the application. It is meant
+// for offline maintenance of the application state, such as rollbacks, and
+// must not be used while the application is running.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -275,14 +319,40 @@ func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
 
+func (app *BaseApp) setQueryGasLimit(gasLimit uint64) {
+	app.queryGasLimit = gasLimit
+}
+
+func (app *BaseApp) setQueryTimeout(timeout time.Duration) {
+	app.queryTimeout = timeout
+}
+
 func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setStoreDBs(dbs map[string]dbm.DB) {
+	app.storeDBs = dbs
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setFeeOracle(oracle *feeestimate.Oracle) {
+	app.feeOracle = oracle
+	feeestimate.RegisterQueryServer(
+		app.grpcQueryRouter,
+		feeestimate.NewQueryServer(oracle, func() sdk.DecCoins { return app.minGasPrices }),
+	)
+}
+
+func (app *BaseApp) setTxTracing(enabled bool) {
+	if enabled {
+		txtrace.RegisterQueryServer(app.grpcQueryRouter, txTraceServer{app})
+	}
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -473,7 +543,7 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 		msCache = msCache.SetTracingContext(
 			sdk.TraceContext(
 				map[string]interface{}{
-					"txHash": fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+					sdk.TraceContextKeyTxHash: fmt.Sprintf("%X", tmhash.Sum(txBytes)),
 				},
 			),
 		).(sdk.CacheMultiStore)
@@ -483,21 +553,30 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 }
 
 // runTx processes a transaction within a given execution mode, encoded transaction
-// bytes, and the decoded transaction itself. All state transitions occur through
-// a cached Context depending on the mode provided. State only gets persisted
-// if all messages get executed successfully and the execution mode is DeliverTx.
+// bytes, and the decoded transaction itself, on the Context returned by
+// getContextForTx for the mode, or on a Context of a historical state when
+// tracing a transaction. All state transitions occur through a cached Context
+// depending on the mode provided. State only gets persisted if all messages and
+// the PostHandler get executed successfully and the execution mode is DeliverTx.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+func (app *BaseApp) runTx(ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	// record where the gas of simulated txs is consumed, so that it can be
+	// returned along with the gas used
+	var gasMeter sdk.DetailedGasMeter
+	if mode == runTxModeSimulate {
+		gasMeter = sdk.NewDetailedGasMeter(ctx.GasMeter())
+		ctx = ctx.WithGasMeter(gasMeter)
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
@@ -516,6 +595,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		if gasMeter != nil {
+			gInfo.GasBreakdown = sdk.NewGasBreakdown(gasMeter.GasBreakdown())
+		}
 	}()
 
 	// If BlockGasMeter() panics it will be caught by the above recover and will
@@ -590,6 +672,23 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
 	result, err = app.runMsgs(runMsgCtx, msgs, mode)
+	if err == nil && app.postHandler != nil && mode != runTxModeCheck && mode != runTxModeReCheck {
+		// The PostHandler runs on the same cache as the messages, so that its
+		// failure discards their state transitions as well. Like the messages,
+		// it is skipped in (Re)CheckTx.
+		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		newCtx, err := app.postHandler(postCtx, tx, result, mode == runTxModeSimulate)
+		if err != nil {
+			return gInfo, nil, err
+		}
+
+		if !newCtx.IsZero() {
+			postCtx = newCtx
+		}
+
+		result.Events = append(result.Events, postCtx.EventManager().Events().ToABCIEvents()...)
+	}
+
 	if err == nil && mode == runTxModeDeliver {
 		msCache.Write()
 
@@ -611,7 +710,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 	msgLogs := make(sdk.ABCIMessageLogs, 0, len(msgs))
 	events := sdk.EmptyEvents()
 	txData := &sdk.TxData{
-		Data: make([]*sdk.MsgData, 0, len(msgs)),
+		Data:       make([]*sdk.MsgData, 0, len(msgs)),
+		MsgResults: make([]sdk.Result, 0, len(msgs)),
+	}
+
+	// attribute the gas consumed by each message to it
+	gasMeter, detailed := ctx.GasMeter().(sdk.DetailedGasMeter)
+	if detailed {
+		defer gasMeter.SetScope(sdk.GasScope{})
 	}
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
@@ -624,30 +730,54 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		msgRoute := msg.Route()
 		handler := app.router.Route(ctx, msgRoute)
 
+		if detailed {
+			gasMeter.SetScope(sdk.GasScope{Kind: sdk.GasScopeMsg, Name: msg.Type(), MsgIndex: i})
+		}
+
 		if handler == nil {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
 		}
 
+		// the reads of the message reaching the parent of the tx cache are
+		// traced with its index
+		if ctx.MultiStore().TracingEnabled() {
+			ctx.MultiStore().SetTracingContext(sdk.TraceContext(
+				map[string]interface{}{sdk.TraceContextKeyMsgIndex: i},
+			))
+		}
+
 		msgResult, err := handler(ctx, msg)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
 
+		// attribute every event emitted by the message to its index
 		msgEvents := sdk.Events{
 			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type())),
 		}
-		msgEvents = msgEvents.AppendEvents(msgResult.GetEvents())
+		msgEvents = msgEvents.AppendEvents(msgResult.GetEvents()).
+			AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, strconv.Itoa(i)))
 
-		// append message events, data and logs
-		//
-		// Note: Each message result's data must be length-prefixed in order to
-		// separate each result.
+		// append message events, data, results and logs
 		events = events.AppendEvents(msgEvents)
 
 		txData.Data = append(txData.Data, &sdk.MsgData{MsgType: msg.Type(), Data: msgResult.Data})
+		txData.MsgResults = append(txData.MsgResults, sdk.Result{
+			Data:   msgResult.Data,
+			Log:    msgResult.Log,
+			Events: msgEvents.ToABCIEvents(),
+		})
 		msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), msgResult.Log, msgEvents))
 	}
 
+	// the writes of the tx cache are only traced once it is written, for all
+	// of its messages
+	if ctx.MultiStore().TracingEnabled() {
+		ctx.MultiStore().SetTracingContext(sdk.TraceContext(
+			map[string]interface{}{sdk.TraceContextKeyMsgIndex: nil},
+		))
+	}
+
 	data, err := proto.Marshal(txData)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
@@ -13,10 +13,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,6 +26,8 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/multicall"
+	"github.com/cosmos/cosmos-sdk/types/txtrace"
 )
 
 var (
@@ -115,6 +119,27 @@ func TestMountStores(t *testing.T) {
 	require.NotNil(t, store2)
 }
 
+func TestMountStoresWithStoreDBs(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := setupBaseApp(t, SetStoreDBs(map[string]dbm.DB{capKey2.Name(): db}))
+
+	app.cms.GetCommitKVStore(capKey2).(sdk.KVStore).Set([]byte("key"), []byte("value"))
+	app.cms.Commit()
+
+	// the store is held apart from the common DB
+	it, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.True(t, it.Valid())
+	it.Close()
+
+	it, err = dbm.IteratePrefix(app.db, []byte("s/k:"+capKey2.Name()+"/"))
+	require.NoError(t, err)
+	require.False(t, it.Valid())
+	it.Close()
+
+	require.NoError(t, app.Close())
+}
+
 // Test that we can make commits and then reload old versions.
 // Test that LoadLatestVersion actually does.
 func TestLoadVersion(t *testing.T) {
@@ -833,7 +858,10 @@ func TestDeliverTx(t *testing.T) {
 			events := res.GetEvents()
 			require.Len(t, events, 3, "should contain ante handler, message type and counter events respectively")
 			require.Equal(t, counterEvent("ante_handler", counter).ToABCIEvents()[0], events[0], "ante handler event")
-			require.Equal(t, counterEvent(sdk.EventTypeMessage, counter).ToABCIEvents()[0], events[2], "msg handler update counter event")
+			require.Equal(t,
+				counterEvent(sdk.EventTypeMessage, counter).AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0")).ToABCIEvents()[0],
+				events[2], "msg handler update counter event",
+			)
 		}
 
 		app.EndBlock(abci.RequestEndBlock{})
@@ -841,6 +869,48 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// Typed events can be parsed back from the events of a delivered tx, to which
+// the index of their message is appended.
+func TestDeliverTxTypedEvent(t *testing.T) {
+	dog := &testdata.Dog{Name: "Spot", Size_: "big"}
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			if err := ctx.EventManager().EmitTypedEvent(dog); err != nil {
+				return nil, err
+			}
+
+			return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	msgEvents := sdk.MsgEvents(res.Events, 0)
+	require.Len(t, msgEvents, 2)
+
+	event := abci.Event(msgEvents[1])
+	require.Equal(t, proto.MessageName(dog), event.Type)
+	require.Equal(t, sdk.AttributeKeyMsgIndex, string(event.Attributes[len(event.Attributes)-1].Key))
+
+	parsed, err := sdk.ParseTypedEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, dog, parsed)
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -890,6 +960,28 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	msgCounter := getIntFromStore(store, deliverKey)
 	require.Equal(t, int64(3), msgCounter)
 
+	// the events of each message are attributed to its index, and returned
+	// along with its result in the tx data
+	txData, err := sdk.ParseTxData(res.Data)
+	require.NoError(t, err)
+	require.Len(t, txData.MsgResults, 3)
+
+	for i := 0; i < 3; i++ {
+		msgEvents := sdk.MsgEvents(res.Events, i)
+		require.Len(t, msgEvents, 2)
+		require.Equal(t,
+			counterEvent(sdk.EventTypeMessage, int64(i)).AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, fmt.Sprint(i))),
+			msgEvents[1:],
+		)
+		require.Equal(t, msgEvents.ToABCIEvents(), txData.MsgResults[i].Events)
+	}
+
+	// the log of each message is decoded from its result, as in the tx log
+	msgLogs, err := sdk.ParseMsgLogs(res.Data, res.Log)
+	require.NoError(t, err)
+	require.Len(t, msgLogs, 3)
+	require.Equal(t, res.Log, msgLogs.String())
+
 	// replace the second message with a msgCounter2
 
 	tx = newTxCounter(1, 3)
@@ -993,6 +1085,193 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+type gasBreakdownAnteDecorator struct{}
+
+func (gasBreakdownAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.KVStore(capKey1).Get([]byte("foo"))
+	ctx.GasMeter().ConsumeGas(5, "test")
+
+	return next(ctx, tx, simulate)
+}
+
+func TestSimulateTxGasBreakdown(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(gasBreakdownAnteDecorator{}))
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			// decoded txs hold a pointer to the message
+			var counter int64
+			switch m := msg.(type) {
+			case msgCounter:
+				counter = m.Counter
+			case *msgCounter:
+				counter = m.Counter
+			}
+
+			ctx.KVStore(capKey2).Set([]byte("foo"), make([]byte, counter))
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	tx := newTxCounter(0, 1, 2)
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	gInfo, _, err := app.Simulate(txBytes, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5095), gInfo.GasUsed)
+
+	expected := &sdk.GasBreakdown{
+		Stores: []sdk.GasConsumption{{Name: "key1", Gas: 1000}, {Name: "key2", Gas: 4090}},
+		Operations: []sdk.GasConsumption{
+			{Name: "flat", Gas: 5}, {Name: "read", Gas: 1000}, {Name: "write", Gas: 4090},
+		},
+		AnteDecorators: []sdk.GasConsumption{{Name: "baseapp.gasBreakdownAnteDecorator", Gas: 1005}},
+		Msgs:           []sdk.GasConsumption{{Name: "counter1", Gas: 2030}, {Name: "counter1", Gas: 2060}},
+	}
+	require.Equal(t, expected, gInfo.GasBreakdown)
+
+	// the breakdown is returned by the simulate query as well
+	queryResult := app.Query(abci.RequestQuery{Path: "/app/simulate", Data: txBytes})
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+
+	var simRes sdk.SimulationResponse
+	require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
+	require.Equal(t, expected, simRes.GasBreakdown)
+
+	// txs which are not simulated do not record a breakdown
+	gInfo, _, err = app.Deliver(tx)
+	require.NoError(t, err)
+	require.Nil(t, gInfo.GasBreakdown)
+}
+
+func TestTraceTx(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	// the blocks with a BeginBlock changing state are rejected by the checker
+	beginBlockChanges := false
+	checkerOpt := func(bapp *BaseApp) {
+		bapp.SetTraceBlockChecker(func(ctx sdk.Context) error {
+			if beginBlockChanges {
+				return sdkerrors.ErrInvalidRequest
+			}
+
+			return nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, checkerOpt, SetTxTracing(true))
+	require.NotNil(t, app.GRPCQueryRouter().Route("/cosmos.txtrace.Query/TraceTx"))
+
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	// the txs of the second block start from the counters of the first one
+	var txs [][]byte
+	var responses []abci.ResponseDeliverTx
+	for height, counters := range [][]int64{{0}, {1, 2, 3}} {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: int64(height) + 1}})
+
+		for _, counter := range counters {
+			tx := newTxCounter(counter, counter)
+			if counter == 3 {
+				tx.setFailOnHandler(true)
+			}
+
+			txBytes, err := cdc.MarshalBinaryBare(tx)
+			require.NoError(t, err)
+
+			txs = append(txs, txBytes)
+			responses = append(responses, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}))
+		}
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	blockTxs := tmtypes.Txs{txs[1], txs[2], txs[3]}
+	header := abci.Header{Height: 2, DataHash: blockTxs.Hash()}
+
+	res, err := app.TraceTx(sdk.NewInfiniteGasMeter(), header, txs[1:], 1)
+	require.NoError(t, err)
+	require.NoError(t, res.Err())
+	require.Equal(t, uint64(responses[2].GasUsed), res.GasUsed)
+	require.Equal(t, responses[2].Events, res.Result.Events)
+
+	// the counter read by the message is traced with its index, and the one
+	// written by the tx once it is written, for all of its messages
+	var msgRead, txWrite *txtrace.TraceOperation
+	for i, op := range res.Operations {
+		if bytes.Equal(op.Key, deliverKey) {
+			switch op.Operation {
+			case "read":
+				msgRead = &res.Operations[i]
+			case "write":
+				txWrite = &res.Operations[i]
+			}
+		}
+	}
+	require.NotNil(t, msgRead)
+	require.Equal(t, int64(0), msgRead.MsgIndex)
+	counter, _ := binary.Varint(msgRead.Value)
+	require.Equal(t, int64(2), counter)
+
+	require.NotNil(t, txWrite)
+	require.Equal(t, int64(-1), txWrite.MsgIndex)
+	require.Equal(t, capKey1.Name(), txWrite.Store)
+	counter, _ = binary.Varint(txWrite.Value)
+	require.Equal(t, int64(3), counter)
+
+	// a failed tx is traced along with its error
+	res, err = app.TraceTx(sdk.NewInfiniteGasMeter(), header, txs[1:], 2)
+	require.NoError(t, err)
+	require.Equal(t, responses[3].Code, res.Code)
+	require.Equal(t, responses[3].Log, res.Log)
+	require.Nil(t, res.Result)
+	require.NotEmpty(t, res.Operations)
+
+	// the gas of the replayed txs is bounded by the given gas meter
+	_, err = app.TraceTx(sdk.NewGasMeter(uint64(responses[1].GasUsed)), header, txs[1:], 1)
+	require.True(t, sdkerrors.ErrQueryOutOfGas.Is(err), err)
+
+	// the txs must be the ones of the block
+	_, err = app.TraceTx(sdk.NewInfiniteGasMeter(), header, txs[1:3], 1)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err), err)
+	_, err = app.TraceTx(sdk.NewInfiniteGasMeter(), header, txs[1:], 3)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err), err)
+
+	beginBlockChanges = true
+	_, err = app.TraceTx(sdk.NewInfiniteGasMeter(), header, txs[1:], 1)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err), err)
+	beginBlockChanges = false
+
+	// the state preceding the block must be available
+	_, err = app.TraceTx(sdk.NewInfiniteGasMeter(), abci.Header{Height: 1, DataHash: tmtypes.Txs{txs[0]}.Hash()}, txs[:1], 0)
+	require.Error(t, err)
+	_, err = app.TraceTx(sdk.NewInfiniteGasMeter(), abci.Header{Height: 4, DataHash: tmtypes.Txs{txs[0]}.Hash()}, txs[:1], 0)
+	require.Error(t, err)
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1403,6 +1682,81 @@ func TestBaseAppAnteHandler(t *testing.T) {
 	app.Commit()
 }
 
+func TestBaseAppPostHandler(t *testing.T) {
+	anteKey, deliverKey, postKey := []byte("ante-key"), []byte("deliver-key"), []byte("post-key")
+
+	var (
+		failPost bool
+		gasUsed  uint64
+	)
+
+	handlerOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, result *sdk.Result, simulate bool) (sdk.Context, error) {
+			// the messages ran on the same context
+			store := ctx.KVStore(capKey1)
+			require.Equal(t, getIntFromStore(store, anteKey), getIntFromStore(store, deliverKey))
+
+			gasUsed = ctx.GasMeter().GasConsumed()
+			setIntOnStore(store, postKey, getIntFromStore(store, postKey)+1)
+
+			if failPost {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "post handler failure")
+			}
+
+			result.Log = "post handled"
+			ctx.EventManager().EmitEvents(counterEvent("post_handler", tx.(txTest).Counter))
+
+			return ctx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	cdc := codec.New()
+	app := setupBaseApp(t, handlerOpt, routerOpt)
+
+	app.InitChain(abci.RequestInitChain{})
+	registerTestCodec(cdc)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the post handler runs after the messages and can update their result
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, "post handled", res.Log)
+	require.Equal(t, "post_handler", res.Events[len(res.Events)-1].Type)
+	require.NotZero(t, gasUsed)
+
+	store := app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(1), getIntFromStore(store, postKey))
+
+	// a failing post handler reverts the message state transitions
+	failPost = true
+	txBytes, err = cdc.MarshalBinaryBare(newTxCounter(1, 1))
+	require.NoError(t, err)
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Empty(t, res.Events)
+
+	store = app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(2), getIntFromStore(store, anteKey))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(1), getIntFromStore(store, postKey))
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+}
+
 func TestGasConsumptionBadTx(t *testing.T) {
 	gasWanted := uint64(5)
 	anteOpt := func(bapp *BaseApp) {
@@ -1568,6 +1922,85 @@ func TestGRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+func TestMulticallQuery(t *testing.T) {
+	grpcQueryOpt := func(bapp *BaseApp) {
+		testdata.RegisterTestServiceServer(
+			bapp.GRPCQueryRouter(),
+			testdata.TestServiceImpl{},
+		)
+	}
+
+	app := setupBaseApp(t, grpcQueryOpt)
+
+	app.InitChain(abci.RequestInitChain{})
+	for i := 0; i < 2; i++ {
+		header := abci.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.Commit()
+	}
+
+	sayHello, err := multicall.NewCall("/testdata.TestService/SayHello", &testdata.SayHelloRequest{Name: "foo"})
+	require.NoError(t, err)
+	echo, err := multicall.NewCall("/testdata.TestService/Echo", &testdata.EchoRequest{Message: "bar"})
+	require.NoError(t, err)
+	testAny, err := multicall.NewCall("/testdata.TestService/TestAny", &testdata.TestAnyRequest{})
+	require.NoError(t, err)
+	unknown, err := multicall.NewCall("/testdata.TestService/Unknown", &testdata.EchoRequest{})
+	require.NoError(t, err)
+	nested, err := multicall.NewCall(multicallMethod, &multicall.QueryMulticallRequest{})
+	require.NoError(t, err)
+	mismatched, err := multicall.NewCall("/testdata.TestService/SayHello", &testdata.EchoRequest{Message: "foo"})
+	require.NoError(t, err)
+
+	req := multicall.QueryMulticallRequest{Calls: []multicall.Call{sayHello, testAny, unknown, echo, nested, mismatched}}
+	reqBz, err := req.Marshal()
+	require.NoError(t, err)
+
+	for _, height := range []int64{0, 1} {
+		resQuery := app.Query(abci.RequestQuery{
+			Data:   reqBz,
+			Path:   multicallMethod,
+			Height: height,
+		})
+		require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+
+		var res multicall.QueryMulticallResponse
+		require.NoError(t, res.Unmarshal(resQuery.Value))
+		require.Len(t, res.Results, len(req.Calls))
+
+		if height == 0 {
+			require.Equal(t, app.LastBlockHeight(), res.Height)
+		} else {
+			require.Equal(t, height, res.Height)
+		}
+
+		var helloRes testdata.SayHelloResponse
+		require.NoError(t, res.Results[0].UnpackResponse(&helloRes))
+		require.Equal(t, "Hello foo!", helloRes.Greeting)
+
+		var echoRes testdata.EchoResponse
+		require.Error(t, res.Results[0].UnpackResponse(&echoRes))
+		require.NoError(t, res.Results[3].UnpackResponse(&echoRes))
+		require.Equal(t, "bar", echoRes.Message)
+
+		require.Error(t, res.Results[1].Err())
+		require.Nil(t, res.Results[1].Response)
+		require.True(t, sdkerrors.ErrUnknownRequest.Is(res.Results[2].Err()))
+		require.True(t, sdkerrors.ErrInvalidRequest.Is(res.Results[4].Err()))
+		require.True(t, sdkerrors.ErrInvalidType.Is(res.Results[5].Err()))
+	}
+
+	calls := make([]multicall.Call, MaxMulticallCalls+1)
+	for i := range calls {
+		calls[i] = echo
+	}
+
+	reqBz, err = (&multicall.QueryMulticallRequest{Calls: calls}).Marshal()
+	require.NoError(t, err)
+	resQuery := app.Query(abci.RequestQuery{Data: reqBz, Path: multicallMethod})
+	require.NotEqual(t, abci.CodeTypeOK, resQuery.Code)
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
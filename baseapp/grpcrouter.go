@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/encoding/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/multicall"
 )
 
 var protoCodec = encoding.GetCodec(proto.Name)
@@ -19,6 +20,7 @@ var protoCodec = encoding.GetCodec(proto.Name)
 // GRPCQueryRouter routes ABCI Query requests to GRPC handlers
 type GRPCQueryRouter struct {
 	routes      map[string]GRPCQueryHandler
+	methods     map[string]grpcMethodHandler
 	anyUnpacker types.AnyUnpacker
 	serviceData []serviceData
 }
@@ -33,15 +35,24 @@ var _ gogogrpc.Server
 
 // NewGRPCQueryRouter creates a new GRPCQueryRouter
 func NewGRPCQueryRouter() *GRPCQueryRouter {
-	return &GRPCQueryRouter{
-		routes: map[string]GRPCQueryHandler{},
+	qrt := &GRPCQueryRouter{
+		routes:  map[string]GRPCQueryHandler{},
+		methods: map[string]grpcMethodHandler{},
 	}
+
+	multicall.RegisterQueryServer(qrt, multicallServer{qrt})
+
+	return qrt
 }
 
 // GRPCQueryHandler defines a function type which handles ABCI Query requests
 // using gRPC
 type GRPCQueryHandler = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error)
 
+// grpcMethodHandler calls a gRPC method with the request decoded by dec and
+// returns its response message.
+type grpcMethodHandler = func(ctx sdk.Context, dec func(interface{}) error) (interface{}, error)
+
 // Route returns the GRPCQueryHandler for a given query route path or nil
 // if not found
 func (qrt *GRPCQueryRouter) Route(path string) GRPCQueryHandler {
@@ -60,19 +71,15 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 		fqName := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
 		methodHandler := method.Handler
 
-		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		qrt.methods[fqName] = func(ctx sdk.Context, dec func(interface{}) error) (interface{}, error) {
 			// call the method handler from the service description with the handler object,
-			// a wrapped sdk.Context with proto-unmarshaled data from the ABCI request data
-			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
-				err := protoCodec.Unmarshal(req.Data, i)
-				if err != nil {
-					return err
-				}
-				if qrt.anyUnpacker != nil {
-					return types.UnpackInterfaces(i, qrt.anyUnpacker)
-				}
-				return nil
-			}, nil)
+			// a wrapped sdk.Context and the request decoder
+			return methodHandler(handler, sdk.WrapSDKContext(ctx), dec, nil)
+		}
+
+		call := qrt.methods[fqName]
+		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+			res, err := call(ctx, qrt.requestDecoder(req.Data))
 			if err != nil {
 				return abci.ResponseQuery{}, err
 			}
@@ -97,6 +104,21 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 	})
 }
 
+// requestDecoder returns a function decoding the proto-encoded data of a
+// request into the request message of a gRPC method.
+func (qrt *GRPCQueryRouter) requestDecoder(data []byte) func(interface{}) error {
+	return func(i interface{}) error {
+		err := protoCodec.Unmarshal(data, i)
+		if err != nil {
+			return err
+		}
+		if qrt.anyUnpacker != nil {
+			return types.UnpackInterfaces(i, qrt.anyUnpacker)
+		}
+		return nil
+	}
+}
+
 // AnyUnpacker returns the AnyUnpacker for the router
 func (qrt *GRPCQueryRouter) AnyUnpacker() types.AnyUnpacker {
 	return qrt.anyUnpacker
@@ -0,0 +1,119 @@
+package baseapp
+
+import (
+	gocontext "context"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/multicall"
+)
+
+// MaxMulticallCalls defines the maximum number of queries in a single
+// Multicall request.
+const MaxMulticallCalls = 256
+
+const multicallMethod = "/cosmos.multicall.Query/Multicall"
+
+// queryHeightKey is the key of the height a query context was created from.
+type queryHeightKey struct{}
+
+// multicallServer implements the multicall Query service on top of the gRPC
+// methods registered on a GRPCQueryRouter. All calls of a request share the
+// sdk.Context of the request, which is created once per query from a single
+// height, so that their results are consistent with each other.
+type multicallServer struct {
+	qrt *GRPCQueryRouter
+}
+
+var _ multicall.QueryServer = multicallServer{}
+
+// Multicall implements the Query/Multicall gRPC method
+func (s multicallServer) Multicall(c gocontext.Context, req *multicall.QueryMulticallRequest) (*multicall.QueryMulticallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Calls) > MaxMulticallCalls {
+		return nil, status.Errorf(codes.InvalidArgument, "too many calls: %d > %d", len(req.Calls), MaxMulticallCalls)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	results := make([]multicall.CallResult, len(req.Calls))
+
+	for i, call := range req.Calls {
+		res, err := s.call(ctx, call)
+		if err != nil {
+			results[i].Codespace, results[i].Code, results[i].Log = sdkerrors.ABCIInfo(err, false)
+			continue
+		}
+
+		results[i].Response = res
+	}
+
+	return &multicall.QueryMulticallResponse{
+		Height:  queryHeight(ctx),
+		Results: results,
+	}, nil
+}
+
+// queryHeight returns the height of the state a query context was created
+// from, which may be lower than the height of its block header.
+func queryHeight(ctx sdk.Context) int64 {
+	if height, ok := ctx.Context().Value(queryHeightKey{}).(int64); ok {
+		return height
+	}
+
+	return ctx.BlockHeight()
+}
+
+// call executes a single query of a batch, recovering from any panic so that
+// it does not affect the other queries.
+func (s multicallServer) call(ctx sdk.Context, call multicall.Call) (res *types.Any, err error) {
+	defer sdkerrors.Recover(&err)
+
+	if call.Method == multicallMethod {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nested multicall is not supported")
+	}
+
+	handler, ok := s.qrt.methods[call.Method]
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query method %s", call.Method)
+	}
+
+	if call.Request == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	resp, err := handler(ctx, func(i interface{}) error {
+		req, ok := i.(proto.Message)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unexpected request type %T", i)
+		}
+
+		if typeURL := "/" + proto.MessageName(req); call.Request.TypeUrl != typeURL {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "request type %s does not match %s", call.Request.TypeUrl, typeURL)
+		}
+
+		return s.qrt.requestDecoder(call.Request.Value)(i)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unexpected response type %T", resp)
+	}
+
+	resBytes, err := protoCodec.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Any{TypeUrl: "/" + proto.MessageName(msg), Value: resBytes}, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
@@ -47,14 +48,29 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 		// Attach the sdk.Context into the gRPC's context.Context.
 		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
 
+		err = runQuery(sdkCtx, func() (err error) {
+			resp, err = handler(grpcCtx, req)
+			return err
+		})
+
 		// Add relevant gRPC headers
 		if height == 0 {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
 		}
-		md = metadata.Pairs(servergrpc.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		md = metadata.Pairs(
+			servergrpc.GRPCBlockHeightHeader, strconv.FormatInt(height, 10),
+			servergrpc.GRPCQueryGasUsedHeader, strconv.FormatUint(sdkCtx.GasMeter().GasConsumed(), 10),
+		)
 		grpc.SetHeader(grpcCtx, md)
 
-		return handler(grpcCtx, req)
+		switch {
+		case sdkerrors.ErrQueryOutOfGas.Is(err):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case sdkerrors.ErrQueryTimeout.Is(err):
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		}
+
+		return resp, err
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
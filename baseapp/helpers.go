@@ -7,15 +7,15 @@ import (
 )
 
 func (app *BaseApp) Check(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeCheck, nil, tx)
+	return app.runTx(app.getContextForTx(runTxModeCheck, nil), runTxModeCheck, nil, tx)
 }
 
 func (app *BaseApp) Simulate(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeSimulate, txBytes, tx)
+	return app.runTx(app.getContextForTx(runTxModeSimulate, txBytes), runTxModeSimulate, txBytes, tx)
 }
 
 func (app *BaseApp) Deliver(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeDeliver, nil, tx)
+	return app.runTx(app.getContextForTx(runTxModeDeliver, nil), runTxModeDeliver, nil, tx)
 }
 
 // Context with current {check, deliver}State of the app used by tests.
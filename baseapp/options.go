@@ -3,11 +3,13 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"time"
 
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/feeestimate"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -18,6 +20,32 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
 }
 
+// SetCommitWorkers sets the number of stores the multistore associated with
+// the app commits concurrently. A value of one or less commits them serially.
+func SetCommitWorkers(workers int) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.cms.SetCommitWorkers(workers) }
+}
+
+// SetArchiving sets whether the multistore associated with the app archives the
+// writes of each committed version in a flat layout serving historical reads.
+func SetArchiving(enabled bool) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.cms.SetArchiving(enabled) }
+}
+
+// SetArchiveReset sets whether the multistore associated with the app resets an
+// archive that is out of line with the loaded version instead of failing to load.
+func SetArchiveReset(reset bool) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.cms.SetArchiveReset(reset) }
+}
+
+// SetStoreDBs returns a BaseApp option function that holds the persistent
+// stores of the given key names in their own databases rather than in the
+// common DB of the app. It must precede the mounting of the stores. The app
+// takes ownership of the databases, which are closed by BaseApp.Close.
+func SetStoreDBs(dbs map[string]dbm.DB) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setStoreDBs(dbs) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -38,6 +66,18 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
 }
 
+// SetQueryGasLimit returns a BaseApp option function that sets the gas limit of
+// each query. Zero is unlimited.
+func SetQueryGasLimit(gasLimit uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setQueryGasLimit(gasLimit) }
+}
+
+// SetQueryTimeout returns a BaseApp option function that sets the wall-clock
+// timeout of each query. Zero is unlimited.
+func SetQueryTimeout(timeout time.Duration) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setQueryTimeout(timeout) }
+}
+
 // SetTrace will turn on or off trace flag
 func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
@@ -49,6 +89,19 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetFeeOracle provides a BaseApp option function that sets the oracle
+// sampling the gas prices of committed transactions and registers the fee
+// estimation gRPC service.
+func SetFeeOracle(oracle *feeestimate.Oracle) func(*BaseApp) {
+	return func(app *BaseApp) { app.setFeeOracle(oracle) }
+}
+
+// SetTxTracing provides a BaseApp option function that registers the gRPC
+// service tracing committed transactions if enabled.
+func SetTxTracing(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setTxTracing(enabled) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -123,6 +176,26 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetPostHandler sets the handler run after the messages of a transaction
+// executed successfully. See sdk.PostHandler.
+func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
+	if app.sealed {
+		panic("SetPostHandler() on sealed BaseApp")
+	}
+
+	app.postHandler = ph
+}
+
+// SetTraceBlockChecker sets the checker of the blocks whose txs are traced by
+// TraceTx. See TraceBlockChecker.
+func (app *BaseApp) SetTraceBlockChecker(checker TraceBlockChecker) {
+	if app.sealed {
+		panic("SetTraceBlockChecker() on sealed BaseApp")
+	}
+
+	app.traceBlockChecker = checker
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -169,3 +242,17 @@ func (app *BaseApp) SetRouter(router sdk.Router) {
 	}
 	app.router = router
 }
+
+// SetCircuitBreaker sets the circuit breaker the router of the BaseApp checks
+// the messages against before dispatching them.
+func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
+	if app.sealed {
+		panic("SetCircuitBreaker() on sealed BaseApp")
+	}
+
+	router, ok := app.router.(*Router)
+	if !ok {
+		panic(fmt.Sprintf("SetCircuitBreaker() requires a *baseapp.Router, got %T", app.router))
+	}
+	router.SetCircuitBreaker(cb)
+}
@@ -0,0 +1,83 @@
+package baseapp
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// queryGasMeter bounds the gas and the wall-clock time of a query. As a query
+// cannot be interrupted, its deadline is checked whenever it consumes gas, i.e.
+// on every store access.
+type queryGasMeter struct {
+	sdk.GasMeter
+
+	timeout  time.Duration
+	deadline time.Time
+}
+
+// queryTimeoutError is the panic of a queryGasMeter past its deadline.
+type queryTimeoutError struct {
+	descriptor string
+	timeout    time.Duration
+}
+
+// newQueryGasMeter returns the gas meter of a query, bounded by the query gas
+// limit and timeout of the app.
+func (app *BaseApp) newQueryGasMeter() sdk.GasMeter {
+	var meter sdk.GasMeter
+	if app.queryGasLimit > 0 {
+		meter = sdk.NewGasMeter(app.queryGasLimit)
+	} else {
+		meter = sdk.NewInfiniteGasMeter()
+	}
+
+	if app.queryTimeout <= 0 {
+		return meter
+	}
+
+	return &queryGasMeter{
+		GasMeter: meter,
+		timeout:  app.queryTimeout,
+		deadline: time.Now().Add(app.queryTimeout),
+	}
+}
+
+// ConsumeGas panics with a queryTimeoutError past the deadline of the query,
+// and otherwise consumes the gas from the underlying meter.
+func (qgm *queryGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	if time.Now().After(qgm.deadline) {
+		panic(queryTimeoutError{descriptor: descriptor, timeout: qgm.timeout})
+	}
+
+	qgm.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// runQuery runs a query on the given context, turning the out of gas and
+// timeout panics of its gas meter into ErrQueryOutOfGas and ErrQueryTimeout
+// errors.
+func runQuery(ctx sdk.Context, query func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.Wrapf(
+					sdkerrors.ErrQueryOutOfGas, "out of gas in location: %v; gasLimit: %d",
+					rType.Descriptor, ctx.GasMeter().Limit(),
+				)
+
+			case queryTimeoutError:
+				err = sdkerrors.Wrapf(
+					sdkerrors.ErrQueryTimeout, "timed out in location: %v; timeout: %s",
+					rType.descriptor, rType.timeout,
+				)
+
+			default:
+				panic(r)
+			}
+		}
+	}()
+
+	return query()
+}
@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestQueryGasLimitAndTimeout(t *testing.T) {
+	// the querier consumes the gas given by its path after sleeping the given
+	// number of milliseconds
+	querierOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("gas", func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+			sleep, err := time.ParseDuration(path[1])
+			if err != nil {
+				return nil, err
+			}
+			time.Sleep(sleep)
+
+			gas, err := strconv.ParseUint(path[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			ctx.GasMeter().ConsumeGas(gas, "test")
+
+			return []byte("ok"), nil
+		})
+	}
+
+	tests := []struct {
+		name    string
+		options []func(*BaseApp)
+		path    string
+		expErr  *sdkerrors.Error
+	}{
+		{"unbounded", nil, "/custom/gas/1000000000/0s", nil},
+		{"within gas limit", []func(*BaseApp){SetQueryGasLimit(1000)}, "/custom/gas/1000/0s", nil},
+		{"out of gas", []func(*BaseApp){SetQueryGasLimit(1000)}, "/custom/gas/1001/0s", sdkerrors.ErrQueryOutOfGas},
+		{"within timeout", []func(*BaseApp){SetQueryTimeout(time.Second)}, "/custom/gas/10/0s", nil},
+		{"timed out", []func(*BaseApp){SetQueryTimeout(time.Millisecond)}, "/custom/gas/10/10ms", sdkerrors.ErrQueryTimeout},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := setupBaseApp(t, append(tc.options, querierOpt)...)
+			app.InitChain(abci.RequestInitChain{})
+			app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+			app.Commit()
+
+			res := app.Query(abci.RequestQuery{Path: tc.path})
+			if tc.expErr == nil {
+				require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+				require.Equal(t, []byte("ok"), res.Value)
+			} else {
+				require.Equal(t, tc.expErr.ABCICode(), res.Code, res.Log)
+				require.Equal(t, tc.expErr.Codespace(), res.Codespace)
+			}
+		})
+	}
+}
+
+func TestRunQueryRepanics(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	require.PanicsWithValue(t, "boom", func() {
+		_ = runQuery(ctx, func() error { panic("boom") })
+	})
+}
@@ -4,10 +4,18 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// CircuitBreaker defines the contract of the circuit breaker the router checks
+// the messages against before dispatching them to their handler.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, msgTypeURL string) bool
+}
+
 type Router struct {
-	routes map[string]sdk.Handler
+	routes         map[string]sdk.Handler
+	circuitBreaker CircuitBreaker
 }
 
 var _ sdk.Router = NewRouter()
@@ -33,9 +41,27 @@ func (rtr *Router) AddRoute(route sdk.Route) sdk.Router {
 	return rtr
 }
 
-// Route returns a handler for a given route path.
+// SetCircuitBreaker sets the circuit breaker checked before dispatching a
+// message to its handler.
+func (rtr *Router) SetCircuitBreaker(cb CircuitBreaker) {
+	rtr.circuitBreaker = cb
+}
+
+// Route returns a handler for a given route path. If a circuit breaker is set,
+// the handler rejects the messages it disables.
 //
 // TODO: Handle expressive matches.
 func (rtr *Router) Route(_ sdk.Context, path string) sdk.Handler {
-	return rtr.routes[path]
+	handler := rtr.routes[path]
+	if handler == nil || rtr.circuitBreaker == nil {
+		return handler
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if msgTypeURL := sdk.MsgTypeURL(msg); !rtr.circuitBreaker.IsAllowed(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrCircuitTripped, msgTypeURL)
+		}
+
+		return handler(ctx, msg)
+	}
 }
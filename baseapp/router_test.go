@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var testHandler = func(_ sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
@@ -29,3 +31,31 @@ func TestRouter(t *testing.T) {
 		rtr.AddRoute(sdk.NewRoute("testRoute", testHandler))
 	})
 }
+
+type testCircuitBreaker map[string]bool
+
+func (cb testCircuitBreaker) IsAllowed(_ sdk.Context, msgTypeURL string) bool {
+	return !cb[msgTypeURL]
+}
+
+func TestRouterCircuitBreaker(t *testing.T) {
+	rtr := NewRouter()
+	rtr.AddRoute(sdk.NewRoute("testRoute", testHandler))
+
+	msg := testdata.NewTestMsg()
+	cb := testCircuitBreaker{}
+	rtr.SetCircuitBreaker(cb)
+
+	h := rtr.Route(sdk.Context{}, "testRoute")
+	require.NotNil(t, h)
+	_, err := h(sdk.Context{}, msg)
+	require.NoError(t, err)
+
+	// the handler rejects the disabled messages
+	cb[sdk.MsgTypeURL(msg)] = true
+	_, err = h(sdk.Context{}, msg)
+	require.True(t, sdkerrors.ErrCircuitTripped.Is(err), err)
+
+	// no handler is returned for unknown routes
+	require.Nil(t, rtr.Route(sdk.Context{}, "otherRoute"))
+}
@@ -0,0 +1,155 @@
+package baseapp
+
+import (
+	"bytes"
+	gocontext "context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/txtrace"
+)
+
+// maxTraceTxs bounds the number of txs replayed by TraceTx, i.e. the index of
+// the traced tx in its block.
+const maxTraceTxs = 10000
+
+// TraceBlockChecker checks that the txs of a block can be traced without
+// replaying its BeginBlock, given a context on the state committed at the
+// previous height with the header of the block. It returns an error if e.g. a
+// BeginBlocker changes the state the txs are replayed on in this block.
+type TraceBlockChecker func(ctx sdk.Context) error
+
+// TraceTx replays the tx at the given index of a block, given its header and
+// txs, on the state committed at the previous height, in a cached context,
+// after replaying the txs preceding it in the block. The txs must match the
+// data hash of the header. It returns the store operations of the tx along
+// with its events, gas usage and error. The errors of the preceding txs are
+// ignored, as they are part of the block regardless.
+//
+// The gas used by the replayed txs is consumed from the given gas meter, e.g.
+// the one of a query, so that TraceTx fails with ErrQueryOutOfGas or
+// ErrQueryTimeout past the gas limit or deadline of the query.
+//
+// NOTE: BeginBlock is not replayed, as its request is not known from the
+// header. TraceTx fails if the TraceBlockChecker of the app rejects the block,
+// i.e. if the state the txs are replayed on misses the changes of BeginBlock.
+func (app *BaseApp) TraceTx(
+	gasMeter sdk.GasMeter, header abci.Header, txs [][]byte, txIndex uint32,
+) (*txtrace.QueryTraceTxResponse, error) {
+	if header.Height < 2 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot trace the txs of block %d", header.Height)
+	}
+
+	if int(txIndex) >= len(txs) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tx index %d out of the %d txs of the block", txIndex, len(txs))
+	}
+
+	if txIndex >= maxTraceTxs {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot trace a tx preceded by more than %d txs", maxTraceTxs)
+	}
+
+	blockTxs := make(tmtypes.Txs, len(txs))
+	for i, tx := range txs {
+		blockTxs[i] = tx
+	}
+
+	if dataHash := blockTxs.Hash(); !bytes.Equal(dataHash, header.DataHash) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"hash %X of the txs does not match data hash %X of block %d", dataHash, header.DataHash, header.Height,
+		)
+	}
+
+	ms, err := app.cms.CacheMultiStoreWithVersion(header.Height - 1)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"failed to load state at height %d; %s (latest height: %d)", header.Height-1, err, app.LastBlockHeight(),
+		)
+	}
+
+	ctx := sdk.NewContext(ms, header, false, app.logger)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	if app.traceBlockChecker != nil {
+		if err := app.traceBlockChecker(ctx); err != nil {
+			return nil, sdkerrors.Wrapf(err, "cannot trace the txs of block %d", header.Height)
+		}
+	}
+
+	if maxGas := app.getMaximumBlockGas(ctx); maxGas > 0 {
+		ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(maxGas))
+	} else {
+		ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	}
+
+	var res *txtrace.QueryTraceTxResponse
+
+	err = runQuery(ctx.WithGasMeter(gasMeter), func() error {
+		for i, bz := range txs[:txIndex] {
+			tx, err := app.txDecoder(bz)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to decode preceding tx %d", i)
+			}
+
+			// the gas meter of a tx context is set by the AnteHandler
+			gInfo, _, _ := app.runTx(ctx.WithTxBytes(bz), runTxModeDeliver, bz, tx)
+			gasMeter.ConsumeGas(gInfo.GasUsed, "preceding tx")
+		}
+
+		txBytes := txs[txIndex]
+
+		tx, err := app.txDecoder(txBytes)
+		if err != nil {
+			return sdkerrors.Wrap(err, "failed to decode tx")
+		}
+
+		// only the caches of the traced tx write to the trace
+		var trace bytes.Buffer
+		tracedMS := ms.SetTracer(&trace).SetTracingContext(sdk.TraceContext(
+			map[string]interface{}{sdk.TraceContextKeyBlockHeight: header.Height},
+		)).(sdk.CacheMultiStore)
+
+		gInfo, result, err := app.runTx(ctx.WithMultiStore(tracedMS).WithTxBytes(txBytes), runTxModeDeliver, txBytes, tx)
+		gasMeter.ConsumeGas(gInfo.GasUsed, "traced tx")
+
+		res = &txtrace.QueryTraceTxResponse{GasInfo: gInfo, Result: result}
+		if err != nil {
+			res.Codespace, res.Code, res.Log = sdkerrors.ABCIInfo(err, app.trace)
+		}
+
+		res.Operations, err = txtrace.ParseTraceOperations(trace.Bytes())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// txTraceServer implements the tx tracing Query service of a BaseApp.
+type txTraceServer struct {
+	app *BaseApp
+}
+
+var _ txtrace.QueryServer = txTraceServer{}
+
+// TraceTx implements the Query/TraceTx gRPC method
+func (s txTraceServer) TraceTx(goCtx gocontext.Context, req *txtrace.QueryTraceTxRequest) (*txtrace.QueryTraceTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Txs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txs")
+	}
+
+	// the replayed txs consume the gas of the query
+	return s.app.TraceTx(sdk.UnwrapSDKContext(goCtx).GasMeter(), req.Header, req.Txs, req.TxIndex)
+}
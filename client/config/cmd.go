@@ -0,0 +1,186 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+var profileNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// Cmd returns a CLI command to interactively get and set values in
+// client.toml.
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config [key] [value]",
+		Short: "Get and set client configuration values",
+		Long: fmt.Sprintf(`Get and set values in <home>/config/client.toml. These values are used by
+every command unless the corresponding flag is provided explicitly.
+
+Without arguments, print the resolved configuration. With a key, print its
+resolved value. With a key and a value, set the value, or unset it if the
+value is empty. If --%[1]s is given, values are read from and written to that
+profile instead of the defaults. The active profile is selected with the %[2]q
+key.
+
+Supported keys: %[2]s, %[3]s, %[4]s, %[5]s, %[6]s, %[7]s
+
+Example:
+$ <appd> config %[3]s testnet-1 --%[1]s testnet
+$ <appd> config %[2]s testnet
+$ <appd> config %[3]s
+`, flags.FlagProfile, KeyProfile, KeyChainID, KeyNode, KeyKeyringBackend, KeyOutput, KeyBroadcastMode),
+		Args: cobra.RangeArgs(0, 2),
+		// client.toml is managed by this command, so it must not be applied
+		// beforehand by the root command's pre-run hook.
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+		RunE:              runConfigCmd,
+	}
+
+	return cmd
+}
+
+func runConfigCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	homeDir := homeDirFromCmd(cmd, clientCtx)
+	profile := profileFromFlags(cmd.Flags())
+
+	conf, err := ReadConfig(homeDir)
+	if err != nil {
+		return err
+	}
+
+	switch len(args) {
+	case 0:
+		resolved, err := conf.Resolve(profile)
+		if err != nil {
+			return err
+		}
+
+		out, err := yaml.Marshal(resolved)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(string(out))
+
+	case 1:
+		if args[0] == KeyProfile {
+			return clientCtx.PrintString(conf.Profile + "\n")
+		}
+
+		resolved, err := conf.Resolve(profile)
+		if err != nil {
+			return err
+		}
+
+		value, err := resolved.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(value + "\n")
+
+	default:
+		key, value := args[0], args[1]
+
+		switch {
+		case key == KeyProfile:
+			if _, ok := conf.Profiles[value]; value != "" && !ok {
+				return fmt.Errorf("profile %s not found in client configuration", value)
+			}
+
+			conf.Profile = value
+
+		case profile != "":
+			if !profileNameRegex.MatchString(profile) {
+				return fmt.Errorf("invalid profile name %s; only letters, digits, '-' and '_' are allowed", profile)
+			}
+
+			p := conf.Profiles[profile]
+			if err := p.Set(key, value); err != nil {
+				return err
+			}
+
+			conf.Profiles[profile] = p
+
+		default:
+			if err := conf.ClientConfig.Set(key, value); err != nil {
+				return err
+			}
+		}
+
+		return WriteConfigFile(homeDir, conf)
+	}
+}
+
+// ReadFromClientConfig reads client.toml from the home directory and, for every
+// resolved setting whose flag was not explicitly provided, sets the flag on
+// the given command and the corresponding field of the returned Context.
+// This gives explicitly provided flags precedence over the active profile,
+// the active profile precedence over the defaults of client.toml, and the
+// defaults of client.toml precedence over the flags' default values. The RPC
+// client and keyring are only configured through their flags.
+//
+// It is meant to be called in the root command's pre-run hook before
+// client.SetCmdClientContextHandler.
+func ReadFromClientConfig(cmd *cobra.Command, clientCtx client.Context) (client.Context, error) {
+	homeDir := homeDirFromCmd(cmd, clientCtx)
+
+	conf, err := ReadConfig(homeDir)
+	if err != nil {
+		return clientCtx, err
+	}
+
+	resolved, err := conf.Resolve(profileFromFlags(cmd.Flags()))
+	if err != nil {
+		return clientCtx, err
+	}
+
+	flagSet := cmd.Flags()
+	for _, key := range Keys() {
+		value, _ := resolved.Get(key)
+		if value == "" || flagSet.Changed(key) {
+			continue
+		}
+
+		if flagSet.Lookup(key) != nil {
+			if err := flagSet.Set(key, value); err != nil {
+				return clientCtx, err
+			}
+		}
+
+		switch key {
+		case KeyChainID:
+			clientCtx = clientCtx.WithChainID(value)
+
+		case KeyOutput:
+			clientCtx = clientCtx.WithOutputFormat(value)
+
+		case KeyBroadcastMode:
+			clientCtx = clientCtx.WithBroadcastMode(value)
+		}
+	}
+
+	return clientCtx, nil
+}
+
+func homeDirFromCmd(cmd *cobra.Command, clientCtx client.Context) string {
+	if cmd.Flags().Changed(flags.FlagHome) || clientCtx.HomeDir == "" {
+		homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+		return homeDir
+	}
+
+	return clientCtx.HomeDir
+}
+
+func profileFromFlags(flagSet *pflag.FlagSet) string {
+	profile, _ := flagSet.GetString(flags.FlagProfile)
+	return profile
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// Keys of the settings supported in client.toml. Apart from KeyProfile, they
+// match the names of the corresponding command line flags.
+const (
+	KeyProfile        = "profile"
+	KeyChainID        = flags.FlagChainID
+	KeyNode           = flags.FlagNode
+	KeyKeyringBackend = flags.FlagKeyringBackend
+	KeyOutput         = cli.OutputFlag
+	KeyBroadcastMode  = flags.FlagBroadcastMode
+)
+
+// ClientConfig defines a set of client settings. Empty values are unset and
+// fall back to the next level of precedence.
+type ClientConfig struct {
+	ChainID        string `mapstructure:"chain-id" yaml:"chain-id"`
+	Node           string `mapstructure:"node" yaml:"node"`
+	KeyringBackend string `mapstructure:"keyring-backend" yaml:"keyring-backend"`
+	Output         string `mapstructure:"output" yaml:"output"`
+	BroadcastMode  string `mapstructure:"broadcast-mode" yaml:"broadcast-mode"`
+}
+
+// Config defines the content of client.toml: default client settings, a set
+// of named profiles overriding them, and the profile active by default.
+type Config struct {
+	// Profile is the name of the profile used when none is given with the
+	// --profile flag.
+	Profile string `mapstructure:"profile"`
+
+	ClientConfig `mapstructure:",squash"`
+
+	Profiles map[string]ClientConfig `mapstructure:"profiles"`
+}
+
+// ConfigFilePath returns the path of client.toml within the given home
+// directory.
+func ConfigFilePath(homeDir string) string {
+	return filepath.Join(homeDir, "config", "client.toml")
+}
+
+// ReadConfig reads client.toml from the given home directory. An empty Config
+// is returned if the file does not exist.
+func ReadConfig(homeDir string) (*Config, error) {
+	conf := &Config{Profiles: map[string]ClientConfig{}}
+
+	configFilePath := ConfigFilePath(homeDir)
+	if _, err := os.Stat(configFilePath); os.IsNotExist(err) {
+		return conf, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(configFilePath)
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", configFilePath, err)
+	}
+
+	if err := v.Unmarshal(conf); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", configFilePath, err)
+	}
+
+	if conf.Profiles == nil {
+		conf.Profiles = map[string]ClientConfig{}
+	}
+
+	return conf, nil
+}
+
+// Resolve returns the settings of the given profile, or of the active profile
+// if profile is empty, with unset values filled from the defaults.
+func (c *Config) Resolve(profile string) (ClientConfig, error) {
+	if profile == "" {
+		profile = c.Profile
+	}
+
+	resolved := c.ClientConfig
+	if profile == "" {
+		return resolved, nil
+	}
+
+	p, ok := c.Profiles[profile]
+	if !ok {
+		return resolved, fmt.Errorf("profile %s not found in client configuration", profile)
+	}
+
+	for _, key := range Keys() {
+		if value, _ := p.Get(key); value != "" {
+			_ = resolved.Set(key, value)
+		}
+	}
+
+	return resolved, nil
+}
+
+// ProfileNames returns the names of all profiles in sorted order.
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Keys returns the keys of the settings held by a ClientConfig.
+func Keys() []string {
+	return []string{KeyChainID, KeyNode, KeyKeyringBackend, KeyOutput, KeyBroadcastMode}
+}
+
+// Get returns the value of the setting with the given key.
+func (c ClientConfig) Get(key string) (string, error) {
+	switch key {
+	case KeyChainID:
+		return c.ChainID, nil
+	case KeyNode:
+		return c.Node, nil
+	case KeyKeyringBackend:
+		return c.KeyringBackend, nil
+	case KeyOutput:
+		return c.Output, nil
+	case KeyBroadcastMode:
+		return c.BroadcastMode, nil
+	default:
+		return "", fmt.Errorf("unknown client configuration key: %s", key)
+	}
+}
+
+// Set validates and sets the value of the setting with the given key. An
+// empty value unsets it.
+func (c *ClientConfig) Set(key, value string) error {
+	switch key {
+	case KeyChainID:
+		c.ChainID = value
+
+	case KeyNode:
+		c.Node = value
+
+	case KeyKeyringBackend:
+		c.KeyringBackend = value
+
+	case KeyOutput:
+		if value != "" && value != "text" && value != "json" {
+			return fmt.Errorf("invalid output format %s; expected text or json", value)
+		}
+
+		c.Output = value
+
+	case KeyBroadcastMode:
+		switch value {
+		case "", flags.BroadcastSync, flags.BroadcastAsync, flags.BroadcastBlock:
+		default:
+			return fmt.Errorf("invalid broadcast mode %s; expected sync, async or block", value)
+		}
+
+		c.BroadcastMode = value
+
+	default:
+		return fmt.Errorf("unknown client configuration key: %s", key)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func TestReadWriteConfig(t *testing.T) {
+	home := t.TempDir()
+
+	conf, err := config.ReadConfig(home)
+	require.NoError(t, err)
+	require.Equal(t, config.ClientConfig{}, conf.ClientConfig)
+	require.Empty(t, conf.Profiles)
+
+	conf.Profile = "testnet"
+	conf.ChainID = "mainnet-1"
+	conf.Output = "json"
+	conf.Profiles["testnet"] = config.ClientConfig{ChainID: "testnet-1", Node: "tcp://testnet:26657"}
+	conf.Profiles["local"] = config.ClientConfig{BroadcastMode: flags.BroadcastSync}
+	require.NoError(t, config.WriteConfigFile(home, conf))
+
+	read, err := config.ReadConfig(home)
+	require.NoError(t, err)
+	require.Equal(t, conf, read)
+	require.Equal(t, []string{"local", "testnet"}, read.ProfileNames())
+}
+
+func TestConfigResolve(t *testing.T) {
+	conf := &config.Config{
+		Profile:      "testnet",
+		ClientConfig: config.ClientConfig{ChainID: "mainnet-1", Output: "json"},
+		Profiles: map[string]config.ClientConfig{
+			"testnet": {ChainID: "testnet-1"},
+			"local":   {ChainID: "local-1", Output: "text"},
+		},
+	}
+
+	resolved, err := conf.Resolve("")
+	require.NoError(t, err)
+	require.Equal(t, config.ClientConfig{ChainID: "testnet-1", Output: "json"}, resolved)
+
+	resolved, err = conf.Resolve("local")
+	require.NoError(t, err)
+	require.Equal(t, config.ClientConfig{ChainID: "local-1", Output: "text"}, resolved)
+
+	_, err = conf.Resolve("unknown")
+	require.Error(t, err)
+
+	conf.Profile = ""
+	resolved, err = conf.Resolve("")
+	require.NoError(t, err)
+	require.Equal(t, conf.ClientConfig, resolved)
+}
+
+func TestClientConfigSet(t *testing.T) {
+	testCases := []struct {
+		key    string
+		value  string
+		expErr bool
+	}{
+		{config.KeyChainID, "testnet-1", false},
+		{config.KeyNode, "tcp://localhost:26657", false},
+		{config.KeyKeyringBackend, "test", false},
+		{config.KeyOutput, "json", false},
+		{config.KeyOutput, "yaml", true},
+		{config.KeyBroadcastMode, flags.BroadcastAsync, false},
+		{config.KeyBroadcastMode, "fast", true},
+		{config.KeyBroadcastMode, "", false},
+		{"gas", "auto", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.key+"="+tc.value, func(t *testing.T) {
+			var c config.ClientConfig
+
+			err := c.Set(tc.key, tc.value)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			value, err := c.Get(tc.key)
+			require.NoError(t, err)
+			require.Equal(t, tc.value, value)
+		})
+	}
+}
+
+func TestReadFromClientConfig(t *testing.T) {
+	home := t.TempDir()
+
+	conf, err := config.ReadConfig(home)
+	require.NoError(t, err)
+
+	conf.ChainID = "mainnet-1"
+	conf.BroadcastMode = flags.BroadcastSync
+	conf.Profiles["testnet"] = config.ClientConfig{ChainID: "testnet-1"}
+	require.NoError(t, config.WriteConfigFile(home, conf))
+
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String(flags.FlagProfile, "", "")
+		cmd.Flags().String(flags.FlagChainID, "", "")
+		cmd.Flags().String(flags.FlagBroadcastMode, flags.BroadcastBlock, "")
+		require.NoError(t, cmd.ParseFlags(args))
+
+		return cmd
+	}
+
+	clientCtx := client.Context{}.WithHomeDir(home)
+
+	// defaults of client.toml take precedence over flag defaults
+	cmd := newCmd()
+	ctx, err := config.ReadFromClientConfig(cmd, clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, "mainnet-1", ctx.ChainID)
+	require.Equal(t, flags.BroadcastSync, ctx.BroadcastMode)
+	chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+	require.Equal(t, "mainnet-1", chainID)
+
+	// profiles take precedence over defaults of client.toml
+	ctx, err = config.ReadFromClientConfig(newCmd("--profile", "testnet"), clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, "testnet-1", ctx.ChainID)
+	require.Equal(t, flags.BroadcastSync, ctx.BroadcastMode)
+
+	// explicit flags take precedence over profiles
+	cmd = newCmd("--profile", "testnet", "--chain-id", "other-1")
+	ctx, err = config.ReadFromClientConfig(cmd, clientCtx)
+	require.NoError(t, err)
+	require.Empty(t, ctx.ChainID)
+	chainID, _ = cmd.Flags().GetString(flags.FlagChainID)
+	require.Equal(t, "other-1", chainID)
+
+	_, err = config.ReadFromClientConfig(newCmd("--profile", "unknown"), clientCtx)
+	require.Error(t, err)
+}
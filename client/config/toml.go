@@ -0,0 +1,74 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	tmos "github.com/tendermint/tendermint/libs/os"
+)
+
+const defaultConfigTemplate = `# This is a TOML config file.
+# For more information, see https://github.com/toml-lang/toml
+
+# Settings are applied to every command unless the corresponding flag is
+# provided explicitly. Settings of the active profile take precedence over
+# the defaults below. The active profile can be overridden with --profile.
+profile = "{{ .Profile }}"
+
+###############################################################################
+###                           Client Configuration                          ###
+###############################################################################
+
+# The network chain ID
+chain-id = "{{ .ChainID }}"
+
+# <host>:<port> to Tendermint RPC interface for this chain
+node = "{{ .Node }}"
+
+# The keyring's backend (os|file|kwallet|pass|test)
+keyring-backend = "{{ .KeyringBackend }}"
+
+# Output format (text|json)
+output = "{{ .Output }}"
+
+# Transaction broadcasting mode (sync|async|block)
+broadcast-mode = "{{ .BroadcastMode }}"
+{{ range $name, $profile := .Profiles }}
+[profiles.{{ $name }}]
+chain-id = "{{ $profile.ChainID }}"
+node = "{{ $profile.Node }}"
+keyring-backend = "{{ $profile.KeyringBackend }}"
+output = "{{ $profile.Output }}"
+broadcast-mode = "{{ $profile.BroadcastMode }}"
+{{ end }}`
+
+var configTemplate *template.Template
+
+func init() {
+	var err error
+
+	tmpl := template.New("clientConfigFileTemplate")
+
+	if configTemplate, err = tmpl.Parse(defaultConfigTemplate); err != nil {
+		panic(err)
+	}
+}
+
+// WriteConfigFile renders config using the template and writes it to
+// client.toml within the given home directory.
+func WriteConfigFile(homeDir string, config *Config) error {
+	var buffer bytes.Buffer
+
+	if err := configTemplate.Execute(&buffer, config); err != nil {
+		return err
+	}
+
+	configFilePath := ConfigFilePath(homeDir)
+	if err := os.MkdirAll(filepath.Dir(configFilePath), 0755); err != nil {
+		return err
+	}
+
+	return tmos.WriteFile(configFilePath, buffer.Bytes(), 0644)
+}
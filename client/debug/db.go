@@ -0,0 +1,61 @@
+package debug
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// nodeDBs holds the databases of the application state of a node home
+// directory: the application database and the ones of the stores held apart
+// from it, by store name, as configured in the app.toml of the node.
+type nodeDBs struct {
+	app    dbm.DB
+	stores map[string]dbm.DB
+}
+
+// openNodeDBs opens the databases of the application state of a node home
+// directory. The default configuration is used if the node has no app.toml.
+func openNodeDBs(home string) (*nodeDBs, error) {
+	appOpts := viper.New()
+	appOpts.Set(flags.FlagHome, home)
+
+	if configFile := filepath.Join(home, "config", "app.toml"); fileExists(configFile) {
+		appOpts.SetConfigFile(configFile)
+		if err := appOpts.ReadInConfig(); err != nil {
+			return nil, err
+		}
+	}
+
+	app, err := server.OpenApplicationDB(appOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	stores, err := server.OpenStoreDBs(appOpts)
+	if err != nil {
+		app.Close()
+		return nil, err
+	}
+
+	return &nodeDBs{app: app, stores: stores}, nil
+}
+
+// Close closes the databases.
+func (dbs *nodeDBs) Close() error {
+	for _, db := range dbs.stores {
+		db.Close()
+	}
+
+	return dbs.app.Close()
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,247 @@
+package debug
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagHeight = "height"
+
+// maxTraceLineSize bounds the size of a line of a trace file, i.e. of a traced
+// operation with its base64 encoded key and value.
+const maxTraceLineSize = 64 << 20
+
+// ReplayTraceCmd returns a command replaying the store traces written by a node
+// started with --trace-store against a fresh multistore, in order to reproduce
+// its application state for debugging.
+func ReplayTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-trace [out-home] [trace-file]...",
+		Short: "Reproduce an application state by replaying store traces",
+		Long: fmt.Sprintf(`Replay the store traces written by a node started with --trace-store against a
+fresh multistore, committed to the application DB of out-home.
+
+The persistent writes and deletes of the traces, i.e. the ones applied to the
+committed state, are applied to the IAVL store of the same name, and the
+multistore is committed each time the block height of the operations changes.
+Only the stores with persistent operations in the traces are mounted.
+Rotated trace files must be given oldest first. The stores are committed at
+consecutive versions starting from 1, and the app hashes are the ones of the
+node if the traces start at genesis and every store was accessed.
+
+Example:
+$ %s debug replay-trace /tmp/replay ~/.simapp/trace.log.1 ~/.simapp/trace.log --height 120
+`, version.AppName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+
+			names := make(map[string]bool)
+			err = readTraces(args[1:], func(op tracekv.TraceOperation) (bool, error) {
+				if height > 0 && op.BlockHeight > height {
+					return false, nil
+				}
+
+				if op.Persistent && op.Store != "" {
+					names[op.Store] = true
+				}
+
+				return true, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(names) == 0 {
+				return fmt.Errorf("no persistent store operation found in the traces")
+			}
+
+			dbs, err := openNodeDBs(filepath.Clean(args[0]))
+			if err != nil {
+				return err
+			}
+			defer dbs.Close()
+
+			if latest := rootmulti.GetLatestVersion(dbs.app); latest != 0 {
+				return fmt.Errorf("the application DB of %s already holds a state at height %d", args[0], latest)
+			}
+
+			replayer, err := newTraceReplayer(rootmulti.NewStore(dbs.app), names, dbs.stores)
+			if err != nil {
+				return err
+			}
+
+			err = readTraces(args[1:], func(op tracekv.TraceOperation) (bool, error) {
+				if height > 0 && op.BlockHeight > height {
+					return false, nil
+				}
+
+				return true, replayer.apply(op)
+			})
+			if err != nil {
+				return err
+			}
+
+			id := replayer.commit()
+			cmd.Printf("replayed %d operations on %d stores in %d blocks, up to block height %d\n",
+				replayer.ops, len(names), id.Version, replayer.height)
+			cmd.Printf("app hash: %X\n", id.Hash)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Block height after which to stop replaying; defaults to the end of the traces")
+
+	return cmd
+}
+
+// readTraces calls fn on the operations of each line of the trace files, in
+// order, until it returns false or an error.
+func readTraces(files []string, fn func(tracekv.TraceOperation) (bool, error)) error {
+	for _, file := range files {
+		cont, err := readTrace(file, fn)
+		if err != nil {
+			return err
+		}
+
+		if !cont {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func readTrace(file string, fn func(tracekv.TraceOperation) (bool, error)) (bool, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), maxTraceLineSize)
+
+	for line := 1; scanner.Scan(); line++ {
+		var op tracekv.TraceOperation
+		if err := json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			return false, fmt.Errorf("%s:%d: invalid trace operation: %w", file, line, err)
+		}
+
+		cont, err := fn(op)
+		if err != nil {
+			return false, fmt.Errorf("%s:%d: %w", file, line, err)
+		}
+
+		if !cont {
+			return false, nil
+		}
+	}
+
+	return true, scanner.Err()
+}
+
+// traceReplayer applies traced operations to a multistore, committing it
+// whenever their block height changes.
+type traceReplayer struct {
+	rs   *rootmulti.Store
+	keys map[string]*sdk.KVStoreKey
+
+	height  int64 // of the pending block
+	pending bool
+	ops     int
+}
+
+// newTraceReplayer mounts the IAVL stores of the given names on a multistore,
+// in their own databases if any are given for them, and loads it.
+func newTraceReplayer(rs *rootmulti.Store, names map[string]bool, dbs map[string]dbm.DB) (*traceReplayer, error) {
+	r := &traceReplayer{rs: rs, keys: make(map[string]*sdk.KVStoreKey, len(names))}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		key := sdk.NewKVStoreKey(name)
+		r.keys[name] = key
+		rs.MountStoreWithDB(key, sdk.StoreTypeIAVL, dbs[name])
+	}
+
+	if err := rs.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// apply applies a persistent write or delete, and ignores the other operations
+// besides tracking their block height. The multistore is committed when the
+// height changes, so that a block is committed even if it wrote nothing.
+// Operations without a block height, such as the ones of a chain upgrade, are
+// committed along with the block which follows them.
+func (r *traceReplayer) apply(op tracekv.TraceOperation) error {
+	if op.BlockHeight != 0 && op.BlockHeight != r.height {
+		if r.height != 0 {
+			r.commit()
+		}
+
+		r.height = op.BlockHeight
+		r.pending = true
+	}
+
+	if !op.Persistent || op.Store == "" || (op.Operation != tracekv.WriteOp && op.Operation != tracekv.DeleteOp) {
+		return nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(op.Key)
+	if err != nil {
+		return fmt.Errorf("invalid key: %w", err)
+	}
+
+	store := r.rs.GetKVStore(r.keys[op.Store])
+	if op.Operation == tracekv.DeleteOp {
+		store.Delete(key)
+	} else {
+		value, err := base64.StdEncoding.DecodeString(op.Value)
+		if err != nil {
+			return fmt.Errorf("invalid value: %w", err)
+		}
+
+		store.Set(key, value)
+	}
+
+	r.pending = true
+	r.ops++
+
+	return nil
+}
+
+// commit commits the pending block, if any, and returns the last commit ID.
+func (r *traceReplayer) commit() sdk.CommitID {
+	if !r.pending {
+		return r.rs.LastCommitID()
+	}
+
+	r.pending = false
+
+	return r.rs.Commit()
+}
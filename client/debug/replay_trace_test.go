@@ -0,0 +1,115 @@
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// writeTrace commits three blocks to a traced multistore, writing through a
+// block cache and a nested tx cache as the app does, and returns the trace file
+// and the last commit ID.
+func writeTrace(t *testing.T, dir string) (string, sdk.CommitID) {
+	path := filepath.Join(dir, "trace.log")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	acc, bank := sdk.NewKVStoreKey("acc"), sdk.NewKVStoreKey("bank")
+	rs := rootmulti.NewStore(dbm.NewMemDB())
+	rs.MountStoreWithDB(acc, sdk.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(bank, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	rs.SetTracer(f)
+
+	for height := int64(1); height <= 3; height++ {
+		block := rs.CacheMultiStore()
+		block.SetTracingContext(sdk.TraceContext{sdk.TraceContextKeyBlockHeight: height})
+		block.GetKVStore(bank).Get([]byte("supply"))
+
+		tx := block.CacheMultiStore()
+		tx.SetTracingContext(sdk.TraceContext{sdk.TraceContextKeyTxHash: fmt.Sprintf("TX%d", height)})
+		tx.GetKVStore(acc).Set([]byte(fmt.Sprintf("acc%d", height)), []byte("1"))
+		tx.GetKVStore(bank).Set([]byte("supply"), []byte(fmt.Sprint(height)))
+		if height == 3 {
+			tx.GetKVStore(acc).Delete([]byte("acc1"))
+		}
+		tx.Write()
+
+		// a tx which fails is not written
+		failed := block.CacheMultiStore()
+		failed.GetKVStore(acc).Set([]byte("failed"), []byte("1"))
+
+		block.Write()
+		rs.Commit()
+	}
+
+	return path, rs.LastCommitID()
+}
+
+func TestReplayTraceCmd(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replay-trace")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	trace, id := writeTrace(t, dir)
+
+	runReplay := func(args ...string) (string, error) {
+		cmd := ReplayTraceCmd()
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+
+		return out.String(), err
+	}
+
+	home := filepath.Join(dir, "full")
+	out, err := runReplay(home, trace)
+	require.NoError(t, err)
+	require.Contains(t, out, "in 3 blocks, up to block height 3")
+	require.Contains(t, out, fmt.Sprintf("app hash: %X", id.Hash))
+
+	dbs, err := openNodeDBs(home)
+	require.NoError(t, err)
+	hashes, err := rootmulti.GetStoreHashes(dbs.app, 3)
+	require.NoError(t, err)
+	require.Len(t, hashes, 2)
+	require.NoError(t, dbs.Close())
+
+	// the replay stops after the given height
+	out, err = runReplay(filepath.Join(dir, "partial"), trace, "--height", "2")
+	require.NoError(t, err)
+	require.Contains(t, out, "in 2 blocks, up to block height 2")
+
+	// the target must hold no state yet
+	_, err = runReplay(home, trace)
+	require.Error(t, err)
+
+	// stores held in their own databases are replayed into them
+	home = filepath.Join(dir, "split")
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(home, "config", "app.toml"), []byte("[database.stores.bank]\n"), 0600))
+
+	out, err = runReplay(home, trace)
+	require.NoError(t, err)
+	require.Contains(t, out, fmt.Sprintf("app hash: %X", id.Hash))
+
+	dbs, err = openNodeDBs(home)
+	require.NoError(t, err)
+	it, err := dbs.stores["bank"].Iterator(nil, nil)
+	require.NoError(t, err)
+	require.True(t, it.Valid())
+	it.Close()
+	require.NoError(t, dbs.Close())
+}
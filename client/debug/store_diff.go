@@ -0,0 +1,285 @@
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHeightA  = "height-a"
+	flagHeightB  = "height-b"
+	flagMaxDiffs = "max-diffs"
+)
+
+// StoreDiffCmd returns a command comparing the committed application state of
+// two node home directories, or of one at two heights. storeDecoders returns the
+// store decoders of the application modules, as registered by
+// SimulationManager.RegisterStoreDecoders, and is used to print the differing
+// entries of each store.
+func StoreDiffCmd(storeDecoders func() sdk.StoreDecoderRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-diff [home-a] [home-b]",
+		Short: "Compare the application state of two nodes, or of one node at two heights",
+		Long: fmt.Sprintf(`Compare the application state committed by two nodes, or by one node at two
+heights, to find out which keys differ when their app hashes do not match.
+
+The root hashes of the stores committed at each height are compared first, and
+the IAVL trees of the stores that differ are then walked to print their differing
+entries, decoded by the store decoders of the application modules. The databases
+are opened as configured in the app.toml of each node and are only read from. The
+nodes must be stopped, and the compared heights must not be pruned.
+
+Example:
+$ %s debug store-diff ~/.node-a ~/.node-b --height-a 120 --height-b 120
+$ %s debug store-diff ~/.node-a --height-a 119 --height-b 120
+`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			heightA, err := cmd.Flags().GetInt64(flagHeightA)
+			if err != nil {
+				return err
+			}
+
+			heightB, err := cmd.Flags().GetInt64(flagHeightB)
+			if err != nil {
+				return err
+			}
+
+			maxDiffs, err := cmd.Flags().GetInt(flagMaxDiffs)
+			if err != nil {
+				return err
+			}
+
+			homeA, homeB := filepath.Clean(args[0]), filepath.Clean(args[0])
+			if len(args) == 2 {
+				homeB = filepath.Clean(args[1])
+			}
+
+			sameHome := homeA == homeB
+			if sameHome && heightA == heightB {
+				return fmt.Errorf("comparing a single home directory requires two different heights")
+			}
+
+			dbsA, err := openNodeDBs(homeA)
+			if err != nil {
+				return err
+			}
+			defer dbsA.Close()
+
+			dbsB := dbsA
+			if !sameHome {
+				if dbsB, err = openNodeDBs(homeB); err != nil {
+					return err
+				}
+				defer dbsB.Close()
+			}
+
+			if heightA, err = resolveHeight(dbsA.app, heightA); err != nil {
+				return err
+			}
+
+			if heightB, err = resolveHeight(dbsB.app, heightB); err != nil {
+				return err
+			}
+
+			hashesA, err := rootmulti.GetStoreHashes(dbsA.app, heightA)
+			if err != nil {
+				return err
+			}
+
+			hashesB, err := rootmulti.GetStoreHashes(dbsB.app, heightB)
+			if err != nil {
+				return err
+			}
+
+			decoders := storeDecoders()
+			names := unionStoreNames(hashesA, hashesB)
+			differing := 0
+
+			for _, name := range names {
+				hashA, hashB := hashesA[name], hashesB[name]
+				if bytes.Equal(hashA, hashB) {
+					continue
+				}
+
+				differing++
+				cmd.Printf("store %s: %X (A) != %X (B)\n", name, hashA, hashB)
+
+				storeA, err := dbsA.kvStore(name, heightA, hashA)
+				if err != nil {
+					return err
+				}
+
+				storeB, err := dbsB.kvStore(name, heightB, hashB)
+				if err != nil {
+					return err
+				}
+
+				kvAs, kvBs := diffKVStores(storeA, storeB, maxDiffs)
+				for i := range kvAs {
+					switch {
+					case kvAs[i].Value == nil:
+						cmd.Printf("key %X (missing from A)\n", kvAs[i].Key)
+					case kvBs[i].Value == nil:
+						cmd.Printf("key %X (missing from B)\n", kvAs[i].Key)
+					default:
+						cmd.Printf("key %X\n", kvAs[i].Key)
+					}
+
+					cmd.Println(decodeDiff(decoders[name], kvAs[i], kvBs[i]))
+				}
+
+				if maxDiffs > 0 && len(kvAs) == maxDiffs {
+					cmd.Printf("(stopped after %d differing keys)\n", maxDiffs)
+				}
+			}
+
+			cmd.Printf("%d of %d stores differ between height %d (A) and height %d (B)\n",
+				differing, len(names), heightA, heightB)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeightA, 0, "Height of the state of home-a to compare; defaults to the latest height")
+	cmd.Flags().Int64(flagHeightB, 0, "Height of the state of home-b (or home-a) to compare; defaults to the latest height")
+	cmd.Flags().Int(flagMaxDiffs, 20, "Maximum number of differing keys to print per store; 0 prints all")
+
+	return cmd
+}
+
+// resolveHeight returns the given height, or the latest committed height of the
+// DB if zero, checking that the state of that height was committed.
+func resolveHeight(db dbm.DB, height int64) (int64, error) {
+	latest := rootmulti.GetLatestVersion(db)
+
+	switch {
+	case latest == 0:
+		return 0, fmt.Errorf("no committed application state found")
+
+	case height == 0:
+		return latest, nil
+
+	case height < 0 || height > latest:
+		return 0, fmt.Errorf("height %d is out of the committed range [1, %d]", height, latest)
+
+	default:
+		return height, nil
+	}
+}
+
+// kvStore returns the store of the given name at the given version, at which
+// its root hash was hash. Stores with an empty hash are either empty or were
+// not committed at that version, and are read as an empty store. The store is
+// loaded without loading the multistore, which could write to the databases.
+func (dbs *nodeDBs) kvStore(name string, version int64, hash []byte) (sdk.KVStore, error) {
+	if len(hash) == 0 {
+		return dbadapter.Store{DB: dbm.NewMemDB()}, nil
+	}
+
+	store, err := rootmulti.LoadIAVLStoreVersion(dbs.app, dbs.stores[name], name, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load store %s at height %d: %w", name, version, err)
+	}
+
+	return store, nil
+}
+
+// diffKVStores walks two stores in key order and returns the entries whose key
+// is missing from either store or whose values differ, stopping after max
+// differences if max is positive. The entry of a missing key has the key and a
+// nil value.
+func diffKVStores(a, b sdk.KVStore, max int) (kvAs, kvBs []tmkv.Pair) {
+	iterA := a.Iterator(nil, nil)
+	defer iterA.Close()
+
+	iterB := b.Iterator(nil, nil)
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		if max > 0 && len(kvAs) == max {
+			break
+		}
+
+		var cmp int
+
+		switch {
+		case !iterA.Valid():
+			cmp = 1
+		case !iterB.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			kvAs = append(kvAs, tmkv.Pair{Key: iterA.Key(), Value: iterA.Value()})
+			kvBs = append(kvBs, tmkv.Pair{Key: iterA.Key()})
+			iterA.Next()
+
+		case cmp > 0:
+			kvAs = append(kvAs, tmkv.Pair{Key: iterB.Key()})
+			kvBs = append(kvBs, tmkv.Pair{Key: iterB.Key(), Value: iterB.Value()})
+			iterB.Next()
+
+		default:
+			if !bytes.Equal(iterA.Value(), iterB.Value()) {
+				kvAs = append(kvAs, tmkv.Pair{Key: iterA.Key(), Value: iterA.Value()})
+				kvBs = append(kvBs, tmkv.Pair{Key: iterB.Key(), Value: iterB.Value()})
+			}
+
+			iterA.Next()
+			iterB.Next()
+		}
+	}
+
+	return kvAs, kvBs
+}
+
+// decodeDiff returns the differing entries of a key decoded by the store
+// decoder, falling back to hex if there is none or if it fails, as decoders
+// typically panic on keys or values they do not expect.
+func decodeDiff(decoder func(kvA, kvB tmkv.Pair) string, kvA, kvB tmkv.Pair) (out string) {
+	raw := fmt.Sprintf("A: %X\nB: %X", kvA.Value, kvB.Value)
+	if decoder == nil {
+		return raw
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			out = raw
+		}
+	}()
+
+	return decoder(kvA, kvB)
+}
+
+func unionStoreNames(hashes ...map[string][]byte) []string {
+	seen := make(map[string]bool)
+	names := []string{}
+
+	for _, h := range hashes {
+		for name := range h {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,169 @@
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDiffKVStores(t *testing.T) {
+	a := dbadapter.Store{DB: dbm.NewMemDB()}
+	b := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	a.Set([]byte("a"), []byte("1"))
+	b.Set([]byte("a"), []byte("1"))
+	a.Set([]byte("b"), []byte("1"))
+	b.Set([]byte("b"), []byte("2"))
+	a.Set([]byte("c"), []byte("1"))
+	b.Set([]byte("d"), []byte("1"))
+
+	kvAs, kvBs := diffKVStores(a, b, 0)
+	require.Equal(t, []tmkv.Pair{
+		{Key: []byte("b"), Value: []byte("1")},
+		{Key: []byte("c"), Value: []byte("1")},
+		{Key: []byte("d")},
+	}, kvAs)
+	require.Equal(t, []tmkv.Pair{
+		{Key: []byte("b"), Value: []byte("2")},
+		{Key: []byte("c")},
+		{Key: []byte("d"), Value: []byte("1")},
+	}, kvBs)
+
+	kvAs, _ = diffKVStores(a, b, 2)
+	require.Len(t, kvAs, 2)
+}
+
+// writeHome commits the given versions of the state of stores "acc" and "bank"
+// to the application DB of a new home directory, and returns the directory.
+func writeHome(t *testing.T, versions ...map[string]string) string {
+	return writeHomeWithConfig(t, "", versions...)
+}
+
+// writeHomeWithConfig is writeHome for a home directory with the given
+// app.toml, if not empty.
+func writeHomeWithConfig(t *testing.T, appConfig string, versions ...map[string]string) string {
+	home, err := ioutil.TempDir("", "store-diff")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(home) })
+
+	if appConfig != "" {
+		require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(home, "config", "app.toml"), []byte(appConfig), 0600))
+	}
+
+	dbs, err := openNodeDBs(home)
+	require.NoError(t, err)
+	defer dbs.Close()
+
+	acc, bank := sdk.NewKVStoreKey("acc"), sdk.NewKVStoreKey("bank")
+	rs := rootmulti.NewStore(dbs.app)
+	rs.MountStoreWithDB(acc, sdk.StoreTypeIAVL, dbs.stores["acc"])
+	rs.MountStoreWithDB(bank, sdk.StoreTypeIAVL, dbs.stores["bank"])
+	require.NoError(t, rs.LoadLatestVersion())
+
+	for _, kvs := range versions {
+		for k, v := range kvs {
+			rs.GetKVStore(acc).Set([]byte(k), []byte(v))
+			rs.GetKVStore(bank).Set([]byte(k), []byte(k))
+		}
+
+		rs.Commit()
+	}
+
+	return home
+}
+
+// dumpDB returns the entries of the application DB of a home directory.
+func dumpDB(t *testing.T, home string) map[string]string {
+	dbs, err := openNodeDBs(home)
+	require.NoError(t, err)
+	defer dbs.Close()
+
+	iter, err := dbs.app.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	kvs := make(map[string]string)
+	for ; iter.Valid(); iter.Next() {
+		kvs[string(iter.Key())] = string(iter.Value())
+	}
+
+	return kvs
+}
+
+func runStoreDiff(t *testing.T, decoders sdk.StoreDecoderRegistry, args ...string) string {
+	cmd := StoreDiffCmd(func() sdk.StoreDecoderRegistry { return decoders })
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.Execute())
+
+	return out.String()
+}
+
+func TestStoreDiffCmd(t *testing.T) {
+	homeA := writeHome(t, map[string]string{"k1": "v1", "k2": "v2"}, map[string]string{"k3": "v3"})
+	homeB := writeHome(t, map[string]string{"k1": "v1", "k2": "x2"})
+
+	decoders := sdk.StoreDecoderRegistry{
+		"acc": func(kvA, kvB tmkv.Pair) string {
+			if kvA.Value == nil || kvB.Value == nil {
+				panic("missing value")
+			}
+			return fmt.Sprintf("%s != %s", kvA.Value, kvB.Value)
+		},
+	}
+
+	out := runStoreDiff(t, decoders, homeA, homeB, "--height-a", "1")
+	require.Contains(t, out, fmt.Sprintf("key %X\nv2 != x2\n", "k2"))
+	require.NotContains(t, out, "store bank")
+	require.Contains(t, out, "1 of 2 stores differ between height 1 (A) and height 1 (B)")
+
+	// a key missing from one side is printed in hex
+	out = runStoreDiff(t, decoders, homeA, homeB)
+	require.Contains(t, out, fmt.Sprintf("key %X (missing from B)\nA: %X\nB: \n", "k3", "v3"))
+	require.Contains(t, out, "store bank")
+	require.Contains(t, out, "2 of 2 stores differ between height 2 (A) and height 1 (B)")
+
+	// a single home is compared at two heights
+	out = runStoreDiff(t, nil, filepath.Join(homeA, "."), "--height-a", "1", "--height-b", "2")
+	require.Contains(t, out, fmt.Sprintf("key %X (missing from A)\nA: \nB: %X\n", "k3", "v3"))
+
+	// stores held in their own databases are read from them
+	homeC := writeHomeWithConfig(t, "[database.stores.bank]\n", map[string]string{"k1": "v1", "k2": "v2"})
+	out = runStoreDiff(t, nil, homeA, homeC, "--height-a", "1")
+	require.Contains(t, out, "0 of 2 stores differ between height 1 (A) and height 1 (B)")
+
+	require.DirExists(t, filepath.Join(homeC, "data", "store_bank.db"))
+
+	// the application DB is not written to, e.g. to recover from an
+	// interrupted commit
+	dbs, err := openNodeDBs(homeA)
+	require.NoError(t, err)
+	require.NoError(t, dbs.app.Set([]byte("s/pending"), codec.New().MustMarshalBinaryBare(int64(3))))
+	require.NoError(t, dbs.Close())
+
+	before := dumpDB(t, homeA)
+	runStoreDiff(t, nil, homeA, "--height-a", "1", "--height-b", "2")
+	require.Equal(t, before, dumpDB(t, homeA))
+
+	cmd := StoreDiffCmd(func() sdk.StoreDecoderRegistry { return nil })
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{homeA, homeA})
+	require.Error(t, cmd.Execute())
+
+	cmd.SetArgs([]string{homeA, homeB, "--height-a", "3"})
+	require.Error(t, cmd.Execute())
+}
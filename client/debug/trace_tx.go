@@ -0,0 +1,95 @@
+package debug
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/txtrace"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// TraceTxCmd returns a command tracing the execution of a committed tx, by
+// replaying it on the state of the previous height on a node with tx tracing
+// enabled.
+func TraceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-tx [hash]",
+		Short: "Trace the execution of a committed transaction",
+		Long: fmt.Sprintf(`Trace the execution of a committed transaction by replaying it on a node started
+with --tx-tracing.
+
+The node loads the state committed at the height preceding the block of the
+transaction, replays the transactions preceding it in the block, and then
+replays the transaction itself. Its store operations are returned along with
+its events, gas usage and error. The BeginBlocker of the block is not replayed,
+so the node rejects blocks whose BeginBlocker executed scheduled messages. The
+gas of the replayed transactions is bounded by the query gas limit of the node,
+which must not have pruned the state of the height preceding the block.
+
+Example:
+$ %s debug trace-tx 0E4F2BCA6E5C44C26AB9F9F7E9D1A1F06E5B7E2E7FA4F6D7AD4C9B1E2B3A7C1D
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid tx hash %s: %w", args[0], err)
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			resTx, err := node.Tx(hash, false)
+			if err != nil {
+				return err
+			}
+
+			resBlock, err := node.Block(&resTx.Height)
+			if err != nil {
+				return err
+			}
+
+			txs := resBlock.Block.Txs
+			if int(resTx.Index) >= len(txs) {
+				return fmt.Errorf("tx %s not found in block %d", args[0], resTx.Height)
+			}
+
+			// the node checks the txs against the data hash of the header
+			req := &txtrace.QueryTraceTxRequest{
+				Header:  tmtypes.TM2PB.Header(&resBlock.Block.Header),
+				Txs:     make([][]byte, len(txs)),
+				TxIndex: resTx.Index,
+			}
+			for i, tx := range txs {
+				req.Txs[i] = tx
+			}
+
+			queryClient := txtrace.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TraceTx(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,318 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultMaxBroadcastRetries defines the default number of times a
+	// transaction is re-signed and re-broadcast after a recoverable failure.
+	DefaultMaxBroadcastRetries = 5
+
+	// DefaultBroadcastBackoff defines the default initial delay between
+	// broadcast retries. The delay doubles after every failed attempt.
+	DefaultBroadcastBackoff = 500 * time.Millisecond
+
+	// DefaultMaxMsgsPerTx defines the default maximum number of messages
+	// packed into a single transaction by BroadcastBatch.
+	DefaultMaxMsgsPerTx = 10
+)
+
+// AccountManager tracks the account number and sequence of a single signing
+// account locally, so that many transactions can be built, signed and
+// broadcast in succession without querying the AccountRetriever before each
+// one. When a node rejects a transaction because of a sequence mismatch, the
+// manager resumes from the sequence the node expected and retries with an
+// exponential backoff.
+//
+// An AccountManager is safe for concurrent use. Transactions are signed and
+// broadcast one at a time so that sequences are always consumed in order.
+type AccountManager struct {
+	mtx sync.Mutex
+
+	clientCtx    client.Context
+	txf          Factory
+	synced       bool
+	maxRetries   int
+	backoff      time.Duration
+	maxMsgsPerTx int
+}
+
+// NewAccountManager returns a new AccountManager for the account defined by
+// clientCtx.GetFromAddress(). If the provided Factory has a non-zero account
+// number and sequence they are used as the starting point, otherwise they are
+// queried on first use.
+func NewAccountManager(clientCtx client.Context, txf Factory) *AccountManager {
+	return &AccountManager{
+		clientCtx:    clientCtx,
+		txf:          txf,
+		synced:       txf.accountNumber != 0 && txf.sequence != 0,
+		maxRetries:   DefaultMaxBroadcastRetries,
+		backoff:      DefaultBroadcastBackoff,
+		maxMsgsPerTx: DefaultMaxMsgsPerTx,
+	}
+}
+
+// SetMaxRetries sets the maximum number of broadcast retries after a
+// recoverable failure.
+func (am *AccountManager) SetMaxRetries(n int) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	am.maxRetries = n
+}
+
+// SetBackoff sets the initial delay between broadcast retries.
+func (am *AccountManager) SetBackoff(d time.Duration) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	am.backoff = d
+}
+
+// SetMaxMsgsPerTx sets the maximum number of messages BroadcastBatch packs
+// into a single transaction.
+func (am *AccountManager) SetMaxMsgsPerTx(n int) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	am.maxMsgsPerTx = n
+}
+
+// ClientContext returns the client.Context the manager broadcasts with.
+func (am *AccountManager) ClientContext() client.Context {
+	return am.clientCtx
+}
+
+// AccountNumber returns the locally tracked account number.
+func (am *AccountManager) AccountNumber() uint64 {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	return am.txf.accountNumber
+}
+
+// Sequence returns the locally tracked sequence, i.e. the sequence the next
+// transaction will be signed with.
+func (am *AccountManager) Sequence() uint64 {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	return am.txf.sequence
+}
+
+// Sync queries the account number and sequence from the chain and replaces
+// the locally tracked values.
+func (am *AccountManager) Sync() error {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	return am.sync()
+}
+
+func (am *AccountManager) sync() error {
+	if am.txf.accountRetriever == nil {
+		return errors.New("account retriever must be set to sync an account")
+	}
+
+	num, seq, err := am.txf.accountRetriever.GetAccountNumberSequence(am.clientCtx, am.clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	am.txf = am.txf.WithAccountNumber(num).WithSequence(seq)
+	am.synced = true
+
+	return nil
+}
+
+// SignAndBroadcast builds a transaction from the given messages, signs it with
+// the locally tracked sequence and broadcasts it using the context's broadcast
+// mode. The local sequence is incremented once the transaction passes CheckTx.
+// If the node reports a sequence mismatch, the transaction is re-signed with
+// the sequence the node expected and re-broadcast. Transport errors are retried
+// with an exponential backoff. Any other CheckTx failure is returned as is in
+// the TxResponse.
+func (am *AccountManager) SignAndBroadcast(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	return am.signAndBroadcast(msgs...)
+}
+
+func (am *AccountManager) signAndBroadcast(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if !am.synced {
+		if err := am.sync(); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		res *sdk.TxResponse
+		err error
+	)
+
+	backoff := am.backoff
+
+	for attempt := 0; attempt <= am.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+
+		res, err = am.broadcastOnce(msgs...)
+		if err != nil {
+			// Transport failures may or may not have reached the mempool, so
+			// resync before trying again.
+			if syncErr := am.sync(); syncErr != nil {
+				err = syncErr
+			}
+
+			continue
+		}
+
+		// The node checks txs against its mempool state, which is ahead of the
+		// committed state a resync would return, so resume from the sequence it
+		// expected.
+		if expected, ok := ParseSequenceMismatch(res, am.txf.sequence); ok {
+			am.txf = am.txf.WithSequence(expected)
+			err = fmt.Errorf("account sequence mismatch: %s", res.RawLog)
+			continue
+		}
+
+		if res.Code == sdkerrors.SuccessABCICode {
+			am.txf = am.txf.WithSequence(am.txf.sequence + 1)
+		}
+
+		return res, nil
+	}
+
+	return res, err
+}
+
+func (am *AccountManager) broadcastOnce(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf := am.txf
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(am.clientCtx.QueryWithData, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	if txf.EstimateFees() {
+		var err error
+		if txf, err = EstimateFees(am.clientCtx, txf); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Sign(txf, am.clientCtx.GetFromName(), tx); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := am.clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return am.clientCtx.BroadcastTx(txBytes)
+}
+
+// BroadcastBatch splits msgs into transactions of at most the configured
+// number of messages each and signs and broadcasts them in order with
+// consecutive sequences. It stops at the first transaction that fails and
+// returns the responses collected so far along with the error, if any.
+func (am *AccountManager) BroadcastBatch(msgs ...sdk.Msg) ([]*sdk.TxResponse, error) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	batches := BatchMsgs(am.maxMsgsPerTx, msgs...)
+	responses := make([]*sdk.TxResponse, 0, len(batches))
+
+	for _, batch := range batches {
+		res, err := am.signAndBroadcast(batch...)
+		if err != nil {
+			return responses, err
+		}
+
+		responses = append(responses, res)
+		if res.Code != sdkerrors.SuccessABCICode {
+			return responses, fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+		}
+	}
+
+	return responses, nil
+}
+
+// BatchMsgs splits msgs into consecutive groups of at most size messages. A
+// non-positive size places all messages in a single group.
+func BatchMsgs(size int, msgs ...sdk.Msg) [][]sdk.Msg {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if size <= 0 {
+		size = len(msgs)
+	}
+
+	batches := make([][]sdk.Msg, 0, (len(msgs)+size-1)/size)
+	for start := 0; start < len(msgs); start += size {
+		end := start + size
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+
+		batches = append(batches, msgs[start:end])
+	}
+
+	return batches
+}
+
+// sigVerificationFailedRegexp matches the error returned by the ante handler
+// when a signature does not verify against the signer's account, capturing the
+// account sequence the node expected.
+var sigVerificationFailedRegexp = regexp.MustCompile(
+	`signature verification failed; verify correct account number \(\d+\), account sequence \((\d+)\), and chain-id`,
+)
+
+// ParseSequenceMismatch returns the account sequence the node expected if the
+// given TxResponse reports that a transaction signed with the given sequence
+// was rejected because of a sequence mismatch. Signatures commit to the
+// sequence, so a mismatch surfaces as a signature verification failure that
+// reports the expected sequence. A failure reporting the sequence the
+// transaction was signed with is caused by something else, e.g. a wrong
+// chain-id or key, and is not a mismatch.
+func ParseSequenceMismatch(res *sdk.TxResponse, signed uint64) (uint64, bool) {
+	if res == nil || res.Codespace != sdkerrors.RootCodespace || res.Code != sdkerrors.ErrUnauthorized.ABCICode() {
+		return 0, false
+	}
+
+	matches := sigVerificationFailedRegexp.FindStringSubmatch(res.RawLog)
+	if matches == nil {
+		return 0, false
+	}
+
+	expected, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil || expected == signed {
+		return 0, false
+	}
+
+	return expected, true
+}
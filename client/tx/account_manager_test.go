@@ -0,0 +1,106 @@
+package tx_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestAccountManagerSync(t *testing.T) {
+	addr := sdk.AccAddress("from")
+	ar := client.TestAccountRetriever{Accounts: map[string]struct {
+		Address sdk.AccAddress
+		Num     uint64
+		Seq     uint64
+	}{
+		addr.String(): {addr, 7, 42},
+	}}
+
+	clientCtx := client.Context{}.WithFromAddress(addr)
+	txf := tx.Factory{}.WithAccountRetriever(ar)
+
+	am := tx.NewAccountManager(clientCtx, txf)
+	require.Equal(t, uint64(0), am.Sequence())
+
+	require.NoError(t, am.Sync())
+	require.Equal(t, uint64(7), am.AccountNumber())
+	require.Equal(t, uint64(42), am.Sequence())
+
+	am = tx.NewAccountManager(client.Context{}.WithFromAddress(sdk.AccAddress("unknown")), txf)
+	require.Error(t, am.Sync())
+
+	am = tx.NewAccountManager(clientCtx, tx.Factory{})
+	require.Error(t, am.Sync())
+}
+
+func TestParseSequenceMismatch(t *testing.T) {
+	sigVerificationFailed := func(seq int) *sdk.TxResponse {
+		return &sdk.TxResponse{
+			Codespace: sdkerrors.RootCodespace,
+			Code:      sdkerrors.ErrUnauthorized.ABCICode(),
+			RawLog: fmt.Sprintf(
+				"signature verification failed; verify correct account number (1), account sequence (%d), and chain-id (test): unauthorized", seq,
+			),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		res      *sdk.TxResponse
+		expSeq   uint64
+		expMatch bool
+	}{
+		{"nil response", nil, 0, false},
+		{"success", &sdk.TxResponse{}, 0, false},
+		{"sequence behind", sigVerificationFailed(5), 5, true},
+		{"sequence ahead", sigVerificationFailed(1), 1, true},
+		{"same sequence", sigVerificationFailed(3), 0, false},
+		{
+			"other unauthorized",
+			&sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrUnauthorized.ABCICode(), RawLog: "unauthorized"},
+			0, false,
+		},
+		{
+			"invalid sequence",
+			&sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrInvalidSequence.ABCICode()},
+			0, false,
+		},
+		{
+			"other codespace",
+			&sdk.TxResponse{Codespace: "bank", Code: sdkerrors.ErrUnauthorized.ABCICode(), RawLog: sigVerificationFailed(5).RawLog},
+			0, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			seq, ok := tx.ParseSequenceMismatch(tc.res, 3)
+			require.Equal(t, tc.expMatch, ok)
+			require.Equal(t, tc.expSeq, seq)
+		})
+	}
+}
+
+func TestBatchMsgs(t *testing.T) {
+	msgs := make([]sdk.Msg, 5)
+	for i := range msgs {
+		msgs[i] = banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+	}
+
+	require.Nil(t, tx.BatchMsgs(2))
+	require.Len(t, tx.BatchMsgs(0, msgs...), 1)
+
+	batches := tx.BatchMsgs(2, msgs...)
+	require.Len(t, batches, 3)
+	require.Len(t, batches[0], 2)
+	require.Len(t, batches[1], 2)
+	require.Len(t, batches[2], 1)
+}
@@ -0,0 +1,286 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultInclusionPollInterval defines the default interval at which the
+	// Broadcaster polls the node for a broadcast transaction.
+	DefaultInclusionPollInterval = time.Second
+
+	// DefaultInclusionTimeout defines the default duration the Broadcaster
+	// waits for a broadcast transaction to be included in a block.
+	DefaultInclusionTimeout = time.Minute
+)
+
+// TxStatus defines the final status of a transaction submitted through a
+// Broadcaster.
+type TxStatus int
+
+const (
+	// TxStatusIncluded means the transaction was included in a block and
+	// executed successfully.
+	TxStatusIncluded TxStatus = iota
+	// TxStatusFailed means the transaction was included in a block but its
+	// execution failed.
+	TxStatusFailed
+	// TxStatusRejected means the transaction was rejected before reaching a
+	// block, either by CheckTx or because it could not be broadcast.
+	TxStatusRejected
+	// TxStatusTimeout means the transaction was accepted into the mempool but
+	// was not seen in a block before the inclusion timeout.
+	TxStatusTimeout
+)
+
+// String implements the Stringer interface.
+func (s TxStatus) String() string {
+	switch s {
+	case TxStatusIncluded:
+		return "included"
+	case TxStatusFailed:
+		return "failed"
+	case TxStatusRejected:
+		return "rejected"
+	case TxStatusTimeout:
+		return "timeout"
+	default:
+		return "unknown"
+	}
+}
+
+// TxResult reports the outcome of a transaction submitted through a
+// Broadcaster.
+type TxResult struct {
+	// Msgs are the messages the transaction was built from.
+	Msgs []sdk.Msg
+	// TxHash is the hex encoded hash of the transaction, if it was broadcast.
+	TxHash string
+	// Height is the height the transaction was included at, if any.
+	Height int64
+	// Status is the final status of the transaction.
+	Status TxStatus
+	// Response is the last response obtained from the node, if any.
+	Response *sdk.TxResponse
+	// Err holds the error that caused a rejection or a timeout, if any.
+	Err error
+}
+
+// ErrBroadcasterStopped is the error of the submissions rejected because the
+// Broadcaster was stopped.
+var ErrBroadcasterStopped = errors.New("broadcaster stopped")
+
+type broadcastRequest struct {
+	msgs   []sdk.Msg
+	result chan TxResult
+}
+
+// Broadcaster asynchronously signs and broadcasts transactions through an
+// AccountManager and tracks each of them until it is included in a block, it
+// is rejected or the inclusion timeout expires.
+type Broadcaster struct {
+	am           *AccountManager
+	requests     chan broadcastRequest
+	pollInterval time.Duration
+	timeout      time.Duration
+
+	// mtx guards stopped, it is held for reading by the pending submissions so
+	// that none is queued once the queue is drained on stop.
+	mtx     sync.RWMutex
+	stopped bool
+	done    chan struct{}
+}
+
+// NewBroadcaster returns a new Broadcaster submitting transactions through the
+// given AccountManager. queueSize bounds the number of pending submissions.
+func NewBroadcaster(am *AccountManager, queueSize int) *Broadcaster {
+	return &Broadcaster{
+		am:           am,
+		requests:     make(chan broadcastRequest, queueSize),
+		pollInterval: DefaultInclusionPollInterval,
+		timeout:      DefaultInclusionTimeout,
+		done:         make(chan struct{}),
+	}
+}
+
+// SetPollInterval sets the interval at which inclusion is polled. It must be
+// called before Start.
+func (b *Broadcaster) SetPollInterval(d time.Duration) { b.pollInterval = d }
+
+// SetInclusionTimeout sets the duration to wait for inclusion. It must be
+// called before Start.
+func (b *Broadcaster) SetInclusionTimeout(d time.Duration) { b.timeout = d }
+
+// Start processes submissions until ctx is cancelled. Transactions are
+// broadcast sequentially in submission order while their inclusion is
+// tracked concurrently. Once ctx is cancelled, the queued and later
+// submissions are rejected with ErrBroadcasterStopped. Start must be called
+// at most once.
+func (b *Broadcaster) Start(ctx context.Context) {
+	defer b.stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case req := <-b.requests:
+			if ctx.Err() != nil {
+				req.reject(ErrBroadcasterStopped)
+				return
+			}
+
+			res, err := b.am.SignAndBroadcast(req.msgs...)
+			switch {
+			case err != nil:
+				req.result <- TxResult{Msgs: req.msgs, Status: TxStatusRejected, Response: res, Err: err}
+
+			case res.Code != sdkerrors.SuccessABCICode:
+				req.result <- TxResult{
+					Msgs:     req.msgs,
+					TxHash:   res.TxHash,
+					Status:   TxStatusRejected,
+					Response: res,
+					Err:      sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog),
+				}
+
+			case res.Height > 0:
+				// broadcast mode block already waited for inclusion
+				req.result <- resultFromResponse(req.msgs, res)
+
+			default:
+				go b.awaitInclusion(ctx, req, res)
+			}
+		}
+	}
+}
+
+// Submit queues the given messages to be broadcast in a single transaction and
+// returns a channel that receives exactly one TxResult once the transaction
+// reaches a final status. Submit blocks while the queue is full, until the
+// Broadcaster is stopped.
+func (b *Broadcaster) Submit(msgs ...sdk.Msg) <-chan TxResult {
+	req := broadcastRequest{msgs: msgs, result: make(chan TxResult, 1)}
+
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	if b.stopped {
+		req.reject(ErrBroadcasterStopped)
+		return req.result
+	}
+
+	select {
+	case b.requests <- req:
+	case <-b.done:
+		req.reject(ErrBroadcasterStopped)
+	}
+
+	return req.result
+}
+
+// stop unblocks the pending submissions, waits for them to return and rejects
+// the queued ones.
+func (b *Broadcaster) stop() {
+	close(b.done)
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.stopped = true
+
+	for {
+		select {
+		case req := <-b.requests:
+			req.reject(ErrBroadcasterStopped)
+		default:
+			return
+		}
+	}
+}
+
+func (req broadcastRequest) reject(err error) {
+	req.result <- TxResult{Msgs: req.msgs, Status: TxStatusRejected, Err: err}
+}
+
+func (b *Broadcaster) awaitInclusion(ctx context.Context, req broadcastRequest, res *sdk.TxResponse) {
+	timeout := time.NewTimer(b.timeout)
+	defer timeout.Stop()
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	hash, err := hex.DecodeString(res.TxHash)
+	if err != nil {
+		req.result <- TxResult{Msgs: req.msgs, TxHash: res.TxHash, Status: TxStatusRejected, Response: res, Err: err}
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			req.result <- TxResult{Msgs: req.msgs, TxHash: res.TxHash, Status: TxStatusTimeout, Response: res, Err: ctx.Err()}
+			return
+
+		case <-timeout.C:
+			req.result <- TxResult{
+				Msgs:     req.msgs,
+				TxHash:   res.TxHash,
+				Status:   TxStatusTimeout,
+				Response: res,
+				Err:      errors.New("timed out waiting for transaction to be included in a block"),
+			}
+			return
+
+		case <-ticker.C:
+			node, err := b.am.ClientContext().GetNode()
+			if err != nil {
+				req.result <- TxResult{Msgs: req.msgs, TxHash: res.TxHash, Status: TxStatusTimeout, Response: res, Err: err}
+				return
+			}
+
+			resTx, err := node.Tx(hash, false)
+			if err != nil {
+				// not indexed yet
+				continue
+			}
+
+			req.result <- resultFromResponse(req.msgs, &sdk.TxResponse{
+				Height:    resTx.Height,
+				TxHash:    res.TxHash,
+				Codespace: resTx.TxResult.Codespace,
+				Code:      resTx.TxResult.Code,
+				Data:      res.Data,
+				RawLog:    resTx.TxResult.Log,
+				Info:      resTx.TxResult.Info,
+				GasWanted: resTx.TxResult.GasWanted,
+				GasUsed:   resTx.TxResult.GasUsed,
+			})
+			return
+		}
+	}
+}
+
+func resultFromResponse(msgs []sdk.Msg, res *sdk.TxResponse) TxResult {
+	result := TxResult{
+		Msgs:     msgs,
+		TxHash:   res.TxHash,
+		Height:   res.Height,
+		Status:   TxStatusIncluded,
+		Response: res,
+	}
+
+	if res.Code != sdkerrors.SuccessABCICode {
+		result.Status = TxStatusFailed
+		result.Err = sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+
+	return result
+}
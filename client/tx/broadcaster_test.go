@@ -0,0 +1,219 @@
+package tx_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockNode accepts every broadcast tx unless checkTx returns an error for it,
+// and reports every accepted tx as included at height 10.
+type mockNode struct {
+	rpcclient.Client
+
+	mtx     sync.Mutex
+	txs     []tmtypes.Tx
+	checkTx func(i int) abci.ResponseCheckTx
+}
+
+func (n *mockNode) BroadcastTxSync(txBytes tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	res := abci.ResponseCheckTx{}
+	if n.checkTx != nil {
+		res = n.checkTx(len(n.txs))
+	}
+
+	n.txs = append(n.txs, txBytes)
+
+	return &ctypes.ResultBroadcastTx{Code: res.Code, Codespace: res.Codespace, Log: res.Log, Hash: txBytes.Hash()}, nil
+}
+
+func (n *mockNode) Tx(hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	return &ctypes.ResultTx{Hash: hash, Height: 10}, nil
+}
+
+func (n *mockNode) broadcastTxs() []tmtypes.Tx {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	return n.txs
+}
+
+func newTestAccountManager(t *testing.T, node *mockNode) (*tx.AccountManager, sdk.AccAddress) {
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("from", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	txConfig := NewTestTxConfig()
+	clientCtx := client.Context{}.
+		WithClient(node).
+		WithTxConfig(txConfig).
+		WithFromName("from").
+		WithFromAddress(info.GetAddress()).
+		WithBroadcastMode(flags.BroadcastSync)
+	txf := tx.Factory{}.
+		WithKeybase(kr).
+		WithTxConfig(txConfig).
+		WithChainID("test-chain").
+		WithAccountNumber(1).
+		WithSequence(5).
+		WithGas(200000)
+
+	am := tx.NewAccountManager(clientCtx, txf)
+	am.SetBackoff(time.Millisecond)
+
+	return am, info.GetAddress()
+}
+
+func newTestBroadcaster(am *tx.AccountManager, queueSize int) *tx.Broadcaster {
+	b := tx.NewBroadcaster(am, queueSize)
+	b.SetPollInterval(time.Millisecond)
+	b.SetInclusionTimeout(time.Second)
+
+	return b
+}
+
+func sigVerificationFailed(seq uint64) abci.ResponseCheckTx {
+	return abci.ResponseCheckTx{
+		Codespace: sdkerrors.RootCodespace,
+		Code:      sdkerrors.ErrUnauthorized.ABCICode(),
+		Log: fmt.Sprintf(
+			"signature verification failed; verify correct account number (1), account sequence (%d), and chain-id (test-chain): unauthorized", seq,
+		),
+	}
+}
+
+func TestBroadcasterRetriesSequenceMismatch(t *testing.T) {
+	node := &mockNode{checkTx: func(i int) abci.ResponseCheckTx {
+		if i == 0 {
+			// the node's mempool already holds txs of sequences 5 and 6
+			return sigVerificationFailed(7)
+		}
+
+		return abci.ResponseCheckTx{}
+	}}
+	am, from := newTestAccountManager(t, node)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBroadcaster(am, 1)
+	go b.Start(ctx)
+
+	msg := banktypes.NewMsgSend(from, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	res := <-b.Submit(msg)
+	require.NoError(t, res.Err)
+	require.Equal(t, tx.TxStatusIncluded, res.Status)
+	require.Equal(t, int64(10), res.Height)
+
+	require.Len(t, node.broadcastTxs(), 2)
+	require.Equal(t, uint64(8), am.Sequence())
+}
+
+func TestBroadcasterDoesNotRetrySignatureFailure(t *testing.T) {
+	// a wrong chain-id or key fails verification with the expected sequence
+	node := &mockNode{checkTx: func(int) abci.ResponseCheckTx { return sigVerificationFailed(5) }}
+	am, from := newTestAccountManager(t, node)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBroadcaster(am, 1)
+	go b.Start(ctx)
+
+	res := <-b.Submit(banktypes.NewMsgSend(from, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	require.Equal(t, tx.TxStatusRejected, res.Status)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(res.Err))
+
+	require.Len(t, node.broadcastTxs(), 1)
+	require.Equal(t, uint64(5), am.Sequence())
+}
+
+func TestBroadcasterOrder(t *testing.T) {
+	node := &mockNode{}
+	am, from := newTestAccountManager(t, node)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newTestBroadcaster(am, 5)
+
+	// submissions are queued before the broadcaster starts
+	results := make([]<-chan tx.TxResult, 5)
+	for i := range results {
+		amount := sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i+1)))
+		results[i] = b.Submit(banktypes.NewMsgSend(from, sdk.AccAddress("to"), amount))
+	}
+
+	go b.Start(ctx)
+
+	for _, result := range results {
+		res := <-result
+		require.NoError(t, res.Err)
+		require.Equal(t, tx.TxStatusIncluded, res.Status)
+	}
+
+	txs := node.broadcastTxs()
+	require.Len(t, txs, 5)
+	for i, txBytes := range txs {
+		decoded, err := NewTestTxConfig().TxDecoder()(txBytes)
+		require.NoError(t, err)
+		require.Equal(t, int64(i+1), decoded.GetMsgs()[0].(*banktypes.MsgSend).Amount.AmountOf("stake").Int64())
+	}
+
+	require.Equal(t, uint64(10), am.Sequence())
+}
+
+func TestBroadcasterShutdown(t *testing.T) {
+	node := &mockNode{}
+	am, from := newTestAccountManager(t, node)
+	msg := banktypes.NewMsgSend(from, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := newTestBroadcaster(am, 1)
+
+	// fill the queue and block a second submission on it
+	queued := b.Submit(msg)
+	blocked := make(chan (<-chan tx.TxResult))
+	go func() { blocked <- b.Submit(msg) }()
+
+	cancel()
+	stopped := make(chan struct{})
+	go func() {
+		b.Start(ctx)
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("broadcaster did not stop")
+	}
+
+	for _, result := range []<-chan tx.TxResult{queued, <-blocked, b.Submit(msg)} {
+		res := <-result
+		require.Equal(t, tx.TxStatusRejected, res.Status)
+		require.Equal(t, tx.ErrBroadcasterStopped, res.Err)
+	}
+
+	require.Empty(t, node.broadcastTxs())
+}
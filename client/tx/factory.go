@@ -26,6 +26,7 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	estimateFees       bool
 }
 
 const (
@@ -90,6 +91,17 @@ func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRe
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// EstimateFees returns the option to derive the fees from the gas prices
+// estimated by the node.
+func (f Factory) EstimateFees() bool { return f.estimateFees }
+
+// WithEstimateFees returns a copy of the Factory with an updated fee
+// estimation value.
+func (f Factory) WithEstimateFees(estimate bool) Factory {
+	f.estimateFees = estimate
+	return f
+}
+
 // WithTxConfig returns a copy of the Factory with an updated TxConfig.
 func (f Factory) WithTxConfig(g client.TxConfig) Factory {
 	f.txConfig = g
@@ -114,14 +126,22 @@ func (f Factory) WithGas(gas uint64) Factory {
 	return f
 }
 
-// WithFees returns a copy of the Factory with an updated fee.
+// WithFees returns a copy of the Factory with an updated fee. If fees is
+// flags.FeesFlagAuto, fees will be estimated by the node prior to signing.
 func (f Factory) WithFees(fees string) Factory {
+	if fees == flags.FeesFlagAuto {
+		f.fees = nil
+		f.estimateFees = true
+		return f
+	}
+
 	parsedFees, err := sdk.ParseCoins(fees)
 	if err != nil {
 		panic(err)
 	}
 
 	f.fees = parsedFees
+	f.estimateFees = false
 	return f
 }
 
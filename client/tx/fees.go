@@ -0,0 +1,46 @@
+package tx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/feeestimate"
+)
+
+// EstimateFees queries the node's fee estimation service and returns a copy
+// of the Factory with its gas prices set to the recommended price of a single
+// denomination, preferring the first denomination accepted by the node's
+// minimum gas prices. The fees are then derived from the gas limit when the
+// transaction is built. If the node has no estimate, the Factory is returned
+// without fees.
+func EstimateFees(clientCtx client.Context, txf Factory) (Factory, error) {
+	if !txf.gasPrices.IsZero() {
+		return txf, errors.New("cannot provide both fees and gas prices")
+	}
+
+	queryClient := feeestimate.NewQueryClient(clientCtx)
+
+	res, err := queryClient.GasPrices(context.Background(), &feeestimate.QueryGasPricesRequest{})
+	if err != nil {
+		return txf, err
+	}
+
+	txf = txf.WithEstimateFees(false)
+	if res.GasPrices.Empty() {
+		return txf, nil
+	}
+
+	gasPrice := res.GasPrices[0]
+	for _, minPrice := range res.MinGasPrices {
+		if amount := res.GasPrices.AmountOf(minPrice.Denom); amount.IsPositive() {
+			gasPrice = sdk.NewDecCoinFromDec(minPrice.Denom, amount)
+			break
+		}
+	}
+
+	txf.gasPrices = sdk.DecCoins{gasPrice}
+
+	return txf, nil
+}
@@ -59,6 +59,17 @@ func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
 	}
 
+	if txf.EstimateFees() {
+		if clientCtx.Offline {
+			return errors.New("cannot estimate fees in offline mode")
+		}
+
+		var err error
+		if txf, err = EstimateFees(clientCtx, txf); err != nil {
+			return err
+		}
+	}
+
 	tx, err := BuildUnsignedTx(txf, msgs...)
 	if err != nil {
 		return err
@@ -82,19 +93,31 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	}
 
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
-		_, adjusted, err := CalculateGas(clientCtx.QueryWithData, txf, msgs...)
+		simRes, adjusted, err := CalculateGas(clientCtx.QueryWithData, txf, msgs...)
 		if err != nil {
 			return err
 		}
 
 		txf = txf.WithGas(adjusted)
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
+
+		// only dry runs report where the gas is consumed
+		gasEst := GasEstimateResponse{GasEstimate: txf.Gas()}
+		if clientCtx.Simulate {
+			gasEst.GasBreakdown = simRes.GasBreakdown
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst)
 	}
 
 	if clientCtx.Simulate {
 		return nil
 	}
 
+	if txf.EstimateFees() {
+		if txf, err = EstimateFees(clientCtx, txf); err != nil {
+			return err
+		}
+	}
+
 	tx, err := BuildUnsignedTx(txf, msgs...)
 	if err != nil {
 		return err
@@ -422,9 +445,14 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder) error {
 
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
-	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
+	GasEstimate  uint64            `json:"gas_estimate" yaml:"gas_estimate"`
+	GasBreakdown *sdk.GasBreakdown `json:"gas_breakdown,omitempty" yaml:"gas_breakdown,omitempty"`
 }
 
 func (gr GasEstimateResponse) String() string {
-	return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
+	if gr.GasBreakdown == nil {
+		return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
+	}
+
+	return fmt.Sprintf("gas estimate: %d\n%s", gr.GasEstimate, gr.GasBreakdown)
 }
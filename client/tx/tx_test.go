@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -164,3 +165,25 @@ func TestSign(t *testing.T) {
 	err = tx.Sign(txf, "non_existing_key", txn)
 	require.Error(t, err)
 }
+
+func TestFactoryWithFeesAuto(t *testing.T) {
+	txf := tx.Factory{}.WithFees(flags.FeesFlagAuto)
+	require.True(t, txf.EstimateFees())
+	require.True(t, txf.Fees().Empty())
+
+	txf = txf.WithFees("50stake")
+	require.False(t, txf.EstimateFees())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), txf.Fees())
+}
+
+func TestGasEstimateResponse(t *testing.T) {
+	gasEst := tx.GasEstimateResponse{GasEstimate: 100}
+	require.Equal(t, "gas estimate: 100", gasEst.String())
+
+	gasEst.GasBreakdown = &sdk.GasBreakdown{
+		Stores:     []sdk.GasConsumption{{Name: "acc", Gas: 60}},
+		Operations: []sdk.GasConsumption{{Name: "flat", Gas: 20}, {Name: "read", Gas: 60}},
+		Msgs:       []sdk.GasConsumption{{Name: "send", Gas: 80}},
+	}
+	require.Equal(t, "gas estimate: 100\nstores:\n  acc: 60\noperations:\n  flat: 20\n  read: 60\nmsgs:\n  send: 80", gasEst.String())
+}
@@ -3,9 +3,12 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/store/cache"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -42,6 +45,33 @@ type BaseConfig struct {
 
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
+
+	// CommitWorkers defines the number of stores committed concurrently at the
+	// end of each block. A value of one or less commits them serially.
+	CommitWorkers uint `mapstructure:"commit-workers"`
+
+	// Archive defines whether the writes of each committed block are archived
+	// in a flat key/value layout, which serves queries at past heights faster
+	// than IAVL at the cost of disk space.
+	Archive bool `mapstructure:"archive"`
+
+	// FeeEstimationBlocks defines the number of recent blocks whose transaction
+	// gas prices are sampled to serve fee estimates. Zero disables fee
+	// estimation.
+	FeeEstimationBlocks uint `mapstructure:"fee-estimation-blocks"`
+
+	// TxTracing enables the gRPC query replaying committed transactions to
+	// trace their execution. Each query replays a block on a historical state,
+	// so it should only be enabled on nodes which are not publicly exposed.
+	TxTracing bool `mapstructure:"tx-tracing"`
+
+	// QueryGasLimit defines the gas limit of each gRPC and legacy query, past
+	// which the query fails. Zero is unlimited.
+	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
+
+	// QueryTimeout defines the wall-clock timeout of each gRPC and legacy
+	// query, past which the query fails. Zero is unlimited.
+	QueryTimeout time.Duration `mapstructure:"query-timeout"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -84,14 +114,88 @@ type GRPCConfig struct {
 	Address string `mapstructure:"address"`
 }
 
+// StoreCacheConfig defines the limits of the inter-block cache of each store,
+// enabled by inter-block-cache.
+type StoreCacheConfig struct {
+	// MaxEntries defines the maximum number of entries cached per store. Zero is
+	// unlimited.
+	MaxEntries uint `mapstructure:"max-entries"`
+
+	// MaxBytes defines the maximum memory, in bytes, taken by the entries cached
+	// per store. Zero is unlimited.
+	MaxBytes uint64 `mapstructure:"max-bytes"`
+
+	// Stores overrides the limits of the caches of the given store key names. A
+	// zero limit defaults to the one above.
+	Stores map[string]StoreCacheLimits `mapstructure:"stores"`
+}
+
+// StoreCacheLimits defines the limits of the inter-block cache of a store.
+type StoreCacheLimits struct {
+	MaxEntries uint   `mapstructure:"max-entries"`
+	MaxBytes   uint64 `mapstructure:"max-bytes"`
+}
+
+// Limits returns the default limits of the inter-block caches and the ones of
+// the overridden stores, by store key name.
+func (c StoreCacheConfig) Limits() (cache.Limits, map[string]cache.Limits) {
+	limits := cache.Limits{MaxEntries: c.MaxEntries, MaxBytes: c.MaxBytes}
+
+	storeLimits := make(map[string]cache.Limits, len(c.Stores))
+	for name, store := range c.Stores {
+		l := limits
+		if store.MaxEntries > 0 {
+			l.MaxEntries = store.MaxEntries
+		}
+		if store.MaxBytes > 0 {
+			l.MaxBytes = store.MaxBytes
+		}
+
+		storeLimits[name] = l
+	}
+
+	return limits, storeLimits
+}
+
+// DatabaseConfig defines the databases holding the application state.
+type DatabaseConfig struct {
+	// Backend defines the database backend of the application database, e.g.
+	// goleveldb, cleveldb, boltdb, rocksdb or memdb, as supported by the build.
+	// It defaults to the backend the binary was built with.
+	Backend string `mapstructure:"backend"`
+
+	// Dir defines the directory of the application database. It defaults to the
+	// data directory of the node, and relative paths are resolved against the
+	// node's home directory.
+	Dir string `mapstructure:"dir"`
+
+	// Stores defines the stores held in their own databases instead of the
+	// application database, by store key name.
+	Stores map[string]StoreDatabaseConfig `mapstructure:"stores"`
+}
+
+// StoreDatabaseConfig defines the database of a store held apart from the
+// application database.
+type StoreDatabaseConfig struct {
+	// Backend defines the database backend of the store. It defaults to the one
+	// of the application database.
+	Backend string `mapstructure:"backend"`
+
+	// Dir defines the directory of the store database. It defaults to the one of
+	// the application database.
+	Dir string `mapstructure:"dir"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
+	Telemetry  telemetry.Config `mapstructure:"telemetry"`
+	API        APIConfig        `mapstructure:"api"`
+	GRPC       GRPCConfig       `mapstructure:"grpc"`
+	StoreCache StoreCacheConfig `mapstructure:"store-cache"`
+	Database   DatabaseConfig   `mapstructure:"database"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -125,12 +229,14 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      defaultMinGasPrices,
-			InterBlockCache:   true,
-			Pruning:           storetypes.PruningOptionDefault,
-			PruningKeepRecent: "0",
-			PruningKeepEvery:  "0",
-			PruningInterval:   "0",
+			MinGasPrices:        defaultMinGasPrices,
+			InterBlockCache:     true,
+			CommitWorkers:       1,
+			FeeEstimationBlocks: 20,
+			Pruning:             storetypes.PruningOptionDefault,
+			PruningKeepRecent:   "0",
+			PruningKeepEvery:    "0",
+			PruningInterval:     "0",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -148,6 +254,14 @@ func DefaultConfig() *Config {
 			Enable:  false,
 			Address: "0.0.0.0:9090",
 		},
+		StoreCache: StoreCacheConfig{
+			MaxEntries: cache.DefaultCommitKVStoreCacheSize,
+			MaxBytes:   cache.DefaultCommitKVStoreCacheMaxBytes,
+			Stores:     map[string]StoreCacheLimits{},
+		},
+		Database: DatabaseConfig{
+			Stores: map[string]StoreDatabaseConfig{},
+		},
 	}
 }
 
@@ -164,14 +278,20 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
+			MinGasPrices:        v.GetString("minimum-gas-prices"),
+			InterBlockCache:     v.GetBool("inter-block-cache"),
+			CommitWorkers:       v.GetUint("commit-workers"),
+			Archive:             v.GetBool("archive"),
+			Pruning:             v.GetString("pruning"),
+			PruningKeepRecent:   v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:    v.GetString("pruning-keep-every"),
+			PruningInterval:     v.GetString("pruning-interval"),
+			HaltHeight:          v.GetUint64("halt-height"),
+			HaltTime:            v.GetUint64("halt-time"),
+			FeeEstimationBlocks: v.GetUint("fee-estimation-blocks"),
+			TxTracing:           v.GetBool("tx-tracing"),
+			QueryGasLimit:       v.GetUint64("query-gas-limit"),
+			QueryTimeout:        v.GetDuration("query-timeout"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -195,5 +315,56 @@ func GetConfig(v *viper.Viper) Config {
 			Enable:  v.GetBool("grpc.enable"),
 			Address: v.GetString("grpc.address"),
 		},
+		StoreCache: GetStoreCacheConfig(v),
+		Database:   GetDatabaseConfig(v),
+	}
+}
+
+// GetStoreCacheConfig returns the parsed inter-block cache configuration from
+// the given options, e.g. a Viper instance or the options passed to an app
+// constructor. Limits which are not set, e.g. in an app.toml written before
+// they were introduced, take their default values.
+func GetStoreCacheConfig(opts interface{ Get(string) interface{} }) StoreCacheConfig {
+	stores := make(map[string]StoreCacheLimits)
+	for name, store := range cast.ToStringMap(opts.Get("store-cache.stores")) {
+		params := cast.ToStringMap(store)
+		stores[name] = StoreCacheLimits{
+			MaxEntries: cast.ToUint(params["max-entries"]),
+			MaxBytes:   cast.ToUint64(params["max-bytes"]),
+		}
+	}
+
+	cfg := StoreCacheConfig{
+		MaxEntries: cache.DefaultCommitKVStoreCacheSize,
+		MaxBytes:   cache.DefaultCommitKVStoreCacheMaxBytes,
+		Stores:     stores,
+	}
+
+	if maxEntries := opts.Get("store-cache.max-entries"); maxEntries != nil {
+		cfg.MaxEntries = cast.ToUint(maxEntries)
+	}
+	if maxBytes := opts.Get("store-cache.max-bytes"); maxBytes != nil {
+		cfg.MaxBytes = cast.ToUint64(maxBytes)
+	}
+
+	return cfg
+}
+
+// GetDatabaseConfig returns the parsed database configuration from the given
+// options, e.g. a Viper instance or the options passed to an app constructor.
+func GetDatabaseConfig(opts interface{ Get(string) interface{} }) DatabaseConfig {
+	stores := make(map[string]StoreDatabaseConfig)
+	for name, store := range cast.ToStringMap(opts.Get("database.stores")) {
+		params := cast.ToStringMapString(store)
+		stores[name] = StoreDatabaseConfig{
+			Backend: params["backend"],
+			Dir:     params["dir"],
+		}
+	}
+
+	return DatabaseConfig{
+		Backend: cast.ToString(opts.Get("database.backend")),
+		Dir:     cast.ToString(opts.Get("database.dir")),
+		Stores:  stores,
 	}
 }
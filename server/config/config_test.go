@@ -1,10 +1,16 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/store/cache"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,3 +24,91 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestDatabaseConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := DefaultConfig()
+	cfg.Database = DatabaseConfig{
+		Backend: "goleveldb",
+		Stores: map[string]StoreDatabaseConfig{
+			"bank":    {Backend: "memdb"},
+			"staking": {Dir: "/mnt/fast"},
+		},
+	}
+
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cfg.Database, GetDatabaseConfig(v))
+
+	// the default config holds every store in the application database
+	WriteConfigFile(path, DefaultConfig())
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, DefaultConfig().Database, GetDatabaseConfig(v))
+}
+
+func TestStoreCacheConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := DefaultConfig()
+	cfg.StoreCache.Stores = map[string]StoreCacheLimits{
+		"bank":    {MaxEntries: 10000},
+		"staking": {MaxEntries: 500, MaxBytes: 1 << 20},
+	}
+
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cfg.StoreCache, GetStoreCacheConfig(v))
+
+	// overridden limits default to the ones of every store
+	limits, storeLimits := cfg.StoreCache.Limits()
+	require.Equal(t, cache.Limits{MaxEntries: cfg.StoreCache.MaxEntries, MaxBytes: cfg.StoreCache.MaxBytes}, limits)
+	require.Equal(t, map[string]cache.Limits{
+		"bank":    {MaxEntries: 10000, MaxBytes: cfg.StoreCache.MaxBytes},
+		"staking": {MaxEntries: 500, MaxBytes: 1 << 20},
+	}, storeLimits)
+
+	// limits which are not set take their default values
+	require.Equal(t, StoreCacheConfig{
+		MaxEntries: cache.DefaultCommitKVStoreCacheSize,
+		MaxBytes:   cache.DefaultCommitKVStoreCacheMaxBytes,
+		Stores:     map[string]StoreCacheLimits{},
+	}, GetStoreCacheConfig(viper.New()))
+}
+
+func TestQueryLimitsConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := DefaultConfig()
+	cfg.QueryGasLimit = 3000000
+	cfg.QueryTimeout = 10 * time.Second
+
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, uint64(3000000), v.GetUint64("query-gas-limit"))
+	require.Equal(t, 10*time.Second, v.GetDuration("query-timeout"))
+
+	// queries are unbounded by default
+	WriteConfigFile(path, DefaultConfig())
+	require.NoError(t, v.ReadInConfig())
+	require.Zero(t, v.GetUint64("query-gas-limit"))
+	require.Zero(t, v.GetDuration("query-timeout"))
+}
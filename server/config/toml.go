@@ -47,6 +47,37 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# CommitWorkers defines the number of stores committed concurrently at the end
+# of each block. A value of one or less commits them serially.
+commit-workers = {{ .BaseConfig.CommitWorkers }}
+
+# Archive defines whether the writes of each committed block are archived in a
+# flat key/value layout, which serves queries at past heights faster than IAVL
+# at the cost of disk space. Enabling it on an existing node seeds the archive
+# with the state of the latest height, from which it then starts. An archive
+# that stopped at an earlier height, e.g. because it was disabled in between, is
+# only reset, discarding the archived heights, with the --archive-reset flag.
+archive = {{ .BaseConfig.Archive }}
+
+# FeeEstimationBlocks defines the number of recent blocks whose transaction gas
+# prices are sampled to serve fee estimates over gRPC. Zero disables fee
+# estimation.
+fee-estimation-blocks = {{ .BaseConfig.FeeEstimationBlocks }}
+
+# TxTracing enables the gRPC query replaying committed transactions to trace
+# their execution. Each query replays a block on a historical state, so it
+# should only be enabled on nodes which are not publicly exposed.
+tx-tracing = {{ .BaseConfig.TxTracing }}
+
+# QueryGasLimit defines the gas limit of each gRPC and legacy query, past which
+# the query fails with the "query out of gas" error. Zero is unlimited.
+query-gas-limit = {{ .BaseConfig.QueryGasLimit }}
+
+# QueryTimeout defines the wall-clock timeout of each gRPC and legacy query
+# (e.g. "10s"), past which the query fails with the "query timed out" error.
+# Zero is unlimited.
+query-timeout = "{{ .BaseConfig.QueryTimeout }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -111,7 +142,66 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
-`
+
+###############################################################################
+###                       Inter-Block Cache Configuration                   ###
+###############################################################################
+
+[store-cache]
+
+# MaxEntries defines the maximum number of entries of the inter-block cache of
+# each store, enabled by inter-block-cache. Zero is unlimited.
+max-entries = {{ .StoreCache.MaxEntries }}
+
+# MaxBytes defines the maximum memory, in bytes, taken by the entries of the
+# inter-block cache of each store, as estimated from the sizes of their keys and
+# values. Zero is unlimited.
+max-bytes = {{ .StoreCache.MaxBytes }}
+
+# The limits may be overridden per store, a zero limit defaulting to the ones
+# above. Cache hits, misses and evictions are emitted as telemetry counters
+# labeled with the store name, which helps tuning them.
+#
+# Example:
+# [store-cache.stores.bank]
+# max-entries = 10000
+# max-bytes = 134217728
+{{ range $name, $store := .StoreCache.Stores }}
+[store-cache.stores.{{ $name }}]
+max-entries = {{ $store.MaxEntries }}
+max-bytes = {{ $store.MaxBytes }}
+{{ end }}
+###############################################################################
+###                         Database Configuration                          ###
+###############################################################################
+
+[database]
+
+# Backend defines the database backend of the application database, e.g.
+# goleveldb, cleveldb, boltdb, rocksdb or memdb, as supported by the build.
+# It defaults to the backend the binary was built with.
+backend = "{{ .Database.Backend }}"
+
+# Dir defines the directory of the application database. It defaults to the
+# data directory of the node, and relative paths are resolved against the
+# node's home directory.
+dir = "{{ .Database.Dir }}"
+
+# Stores may be held in their own databases instead of the application
+# database, each with its own backend and directory defaulting to the ones of
+# the application database. Moving stores between databases, or changing the
+# backend or directory of a database, requires migrating the data with the
+# migrate-db command.
+#
+# Example:
+# [database.stores.bank]
+# backend = "goleveldb"
+# dir = "/mnt/fast/data"
+{{ range $name, $store := .Database.Stores }}
+[database.stores.{{ $name }}]
+backend = "{{ $store.Backend }}"
+dir = "{{ $store.Dir }}"
+{{ end }}`
 
 var configTemplate *template.Template
 
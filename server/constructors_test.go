@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -13,7 +14,7 @@ func Test_openDB(t *testing.T) {
 	t.Parallel()
 	dir, cleanup := testutil.NewTestCaseDir(t)
 	t.Cleanup(cleanup)
-	_, err := openDB(dir)
+	_, err := openDB(dir, viper.New())
 	require.NoError(t, err)
 }
 
@@ -22,12 +23,12 @@ func Test_openTraceWriter(t *testing.T) {
 	dir, cleanup := testutil.NewTestCaseDir(t)
 	t.Cleanup(cleanup)
 	fname := filepath.Join(dir, "logfile")
-	w, err := openTraceWriter(fname)
+	w, err := openTraceWriter(fname, 0, 0)
 	require.NoError(t, err)
 	require.NotNil(t, w)
 
 	// test no-op
-	w, err = openTraceWriter("")
+	w, err = openTraceWriter("", 0, 0)
 	require.NoError(t, err)
 	require.Nil(t, w)
 }
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// storeDBPrefix prefixes the names of the databases of the stores held apart
+// from the application database, so that they do not clash with the ones of
+// Tendermint in the same directory.
+const storeDBPrefix = "store_"
+
+// dbLocation identifies a database by its name, backend and directory.
+type dbLocation struct {
+	name    string
+	backend string
+	dir     string
+}
+
+// String implements fmt.Stringer.
+func (l dbLocation) String() string {
+	return fmt.Sprintf("%s (%s) in %s", l.name, l.backend, l.dir)
+}
+
+func (l dbLocation) open() (dbm.DB, error) {
+	return sdk.NewDB(l.name, dbm.BackendType(l.backend), l.dir)
+}
+
+// applicationDBLocation returns the location of the application database.
+func applicationDBLocation(rootDir string, cfg config.DatabaseConfig) dbLocation {
+	backend := cfg.Backend
+	if backend == "" {
+		backend = sdk.DBBackend
+	}
+	if backend == "" {
+		backend = string(dbm.GoLevelDBBackend)
+	}
+
+	return dbLocation{
+		name:    "application",
+		backend: backend,
+		dir:     resolveDBDir(rootDir, cfg.Dir),
+	}
+}
+
+// storeDBLocation returns the location of the database of a store, and false if
+// the store is held in the application database.
+func storeDBLocation(rootDir string, cfg config.DatabaseConfig, name string) (dbLocation, bool) {
+	store, ok := cfg.Stores[name]
+	if !ok {
+		return dbLocation{}, false
+	}
+
+	loc := applicationDBLocation(rootDir, cfg)
+	loc.name = storeDBPrefix + name
+
+	if store.Backend != "" {
+		loc.backend = store.Backend
+	}
+	if store.Dir != "" {
+		loc.dir = resolveDBDir(rootDir, store.Dir)
+	}
+
+	return loc, true
+}
+
+func resolveDBDir(rootDir, dir string) string {
+	switch {
+	case dir == "":
+		return filepath.Join(rootDir, "data")
+
+	case filepath.IsAbs(dir):
+		return dir
+
+	default:
+		return filepath.Join(rootDir, dir)
+	}
+}
+
+// openDB opens the application database as configured in app.toml.
+func openDB(rootDir string, appOpts types.AppOptions) (dbm.DB, error) {
+	return applicationDBLocation(rootDir, config.GetDatabaseConfig(appOpts)).open()
+}
+
+// OpenApplicationDB opens the application database as configured in app.toml,
+// in the home directory given by the options.
+func OpenApplicationDB(appOpts types.AppOptions) (dbm.DB, error) {
+	return openDB(cast.ToString(appOpts.Get(flags.FlagHome)), appOpts)
+}
+
+// OpenStoreDBs opens the databases of the stores configured in app.toml to be
+// held apart from the application database, by store key name. They are meant
+// to be passed to the app with the baseapp.SetStoreDBs option.
+func OpenStoreDBs(appOpts types.AppOptions) (map[string]dbm.DB, error) {
+	rootDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	cfg := config.GetDatabaseConfig(appOpts)
+
+	dbs := make(map[string]dbm.DB, len(cfg.Stores))
+	for name := range cfg.Stores {
+		loc, _ := storeDBLocation(rootDir, cfg, name)
+
+		db, err := loc.open()
+		if err != nil {
+			for _, db := range dbs {
+				db.Close()
+			}
+
+			return nil, err
+		}
+
+		dbs[name] = db
+	}
+
+	return dbs, nil
+}
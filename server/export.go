@@ -24,7 +24,15 @@ const (
 )
 
 // ExportCmd dumps app state to JSON.
+//
+// Deprecated: use ExportCmdWithOptions.
 func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Command {
+	return ExportCmdWithOptions(appExporter.WithOptions(), defaultNodeHome)
+}
+
+// ExportCmdWithOptions dumps app state to JSON, passing the app options to the
+// exporter.
+func ExportCmdWithOptions(appExporter types.AppExporterWithOptions, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "export",
 		Short: "Export state to JSON",
@@ -38,10 +46,11 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
 			config.SetRoot(homeDir)
 
-			db, err := openDB(config.RootDir)
+			db, err := openDB(config.RootDir, serverCtx.Viper)
 			if err != nil {
 				return err
 			}
+			defer db.Close()
 
 			if appExporter == nil {
 				if _, err := fmt.Fprintln(os.Stderr, "WARNING: App exporter not defined. Returning genesis file."); err != nil {
@@ -58,7 +67,7 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			}
 
 			traceWriterFile, _ := cmd.Flags().GetString(flagTraceStore)
-			traceWriter, err := openTraceWriter(traceWriterFile)
+			traceWriter, err := openTraceWriter(traceWriterFile, 0, 0)
 			if err != nil {
 				return err
 			}
@@ -67,7 +76,7 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			forZeroHeight, _ := cmd.Flags().GetBool(flagForZeroHeight)
 			jailWhiteList, _ := cmd.Flags().GetStringSlice(flagJailWhitelist)
 
-			appState, validators, cp, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailWhiteList)
+			appState, validators, cp, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailWhiteList, serverCtx.Viper)
 			if err != nil {
 				return fmt.Errorf("error exporting state: %v", err)
 			}
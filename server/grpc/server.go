@@ -14,6 +14,9 @@ import (
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+	// GRPCQueryGasUsedHeader is the gRPC header for the gas used by a query.
+	GRPCQueryGasUsedHeader = "x-cosmos-query-gas-used"
 )
 
 // StartGRPCServer starts a gRPC server on the given address.
@@ -0,0 +1,317 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+const (
+	// migrateBatchSize is the number of keys written per batch when migrating.
+	migrateBatchSize = 10000
+
+	// sharedStorePrefix and ownStorePrefix prefix the keys of a store held in the
+	// application database and in its own database, as laid out by the root
+	// multistore.
+	sharedStorePrefix = "s/k:"
+	ownStorePrefix    = "s/_/"
+)
+
+// MigrateDBCmd returns a command migrating the application state to the
+// databases configured in app.toml.
+func MigrateDBCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-db [previous-app-toml]",
+		Short: "Migrate the application state to the databases configured in app.toml",
+		Long: `Migrate the application state from the databases configured in the [database]
+section of a previous app.toml to the ones configured in the current app.toml, e.g.
+after changing the backend of the application database or holding a store in its
+own database.
+
+The node must be stopped, and the target databases must not hold any of the
+migrated data yet. Stores moved out of an application database which remains in
+use are deleted from it, while the other source databases are left untouched and
+may be deleted once the node runs on the migrated ones.
+
+Example:
+$ cp ~/.simapp/config/app.toml ~/app.toml.previous
+$ # edit the [database] section of ~/.simapp/config/app.toml
+$ simd migrate-db ~/app.toml.previous
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			serverCtx.Config.SetRoot(homeDir)
+
+			previous := viper.New()
+			previous.SetConfigFile(args[0])
+			previous.SetConfigType("toml")
+
+			if err := previous.ReadInConfig(); err != nil {
+				return fmt.Errorf("failed to read previous config %s: %w", args[0], err)
+			}
+
+			return migrateDBs(
+				serverCtx.Config.RootDir,
+				config.GetDatabaseConfig(previous),
+				config.GetDatabaseConfig(serverCtx.Viper),
+				cmd.OutOrStdout(),
+			)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// dbMigration copies the keys of a source database with a given prefix to a
+// target database, replacing the prefix, except for the keys with a skipped
+// prefix.
+type dbMigration struct {
+	desc     string
+	from, to dbLocation
+
+	fromPrefix, toPrefix []byte
+	skip                 [][]byte
+}
+
+// migrateDBs migrates the application state from the databases of one database
+// configuration to the ones of another.
+func migrateDBs(rootDir string, from, to config.DatabaseConfig, out io.Writer) error {
+	fromApp, toApp := applicationDBLocation(rootDir, from), applicationDBLocation(rootDir, to)
+
+	names := storeNames(from, to)
+
+	var migrations []dbMigration
+
+	// stores held apart in either configuration are migrated on their own
+	if fromApp != toApp {
+		skip := make([][]byte, len(names))
+		for i, name := range names {
+			skip[i] = []byte(sharedStorePrefix + name + "/")
+		}
+
+		migrations = append(migrations, dbMigration{desc: "application database", from: fromApp, to: toApp, skip: skip})
+	}
+
+	for _, name := range names {
+		m := dbMigration{desc: fmt.Sprintf("store %s", name)}
+		m.from, m.fromPrefix = storeDBData(rootDir, from, name)
+		m.to, m.toPrefix = storeDBData(rootDir, to, name)
+
+		if m.from != m.to || !bytes.Equal(m.fromPrefix, m.toPrefix) {
+			migrations = append(migrations, m)
+		}
+	}
+
+	if len(migrations) == 0 {
+		fmt.Fprintln(out, "The databases are unchanged, nothing to migrate")
+		return nil
+	}
+
+	dbs := make(map[dbLocation]dbm.DB)
+	defer func() {
+		for _, db := range dbs {
+			db.Close()
+		}
+	}()
+
+	open := func(loc dbLocation) (dbm.DB, error) {
+		if db, ok := dbs[loc]; ok {
+			return db, nil
+		}
+
+		db, err := loc.open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open database %s: %w", loc, err)
+		}
+
+		dbs[loc] = db
+
+		return db, nil
+	}
+
+	// check all targets first, so that a migration is not left half done
+	for _, m := range migrations {
+		db, err := open(m.to)
+		if err != nil {
+			return err
+		}
+
+		empty, err := isPrefixEmpty(db, m.toPrefix)
+		if err != nil {
+			return err
+		}
+
+		if !empty {
+			return fmt.Errorf("cannot migrate %s: database %s already holds its data", m.desc, m.to)
+		}
+	}
+
+	for _, m := range migrations {
+		src, err := open(m.from)
+		if err != nil {
+			return err
+		}
+
+		dst, err := open(m.to)
+		if err != nil {
+			return err
+		}
+
+		n, err := copyDBPrefix(src, dst, m.fromPrefix, m.toPrefix, m.skip)
+		if err != nil {
+			return fmt.Errorf("failed to migrate %s: %w", m.desc, err)
+		}
+
+		fmt.Fprintf(out, "Migrated %d keys of %s from %s to %s\n", n, m.desc, m.from, m.to)
+
+		// the data of a store moved out of the application database in use would
+		// otherwise be left stale in it
+		if m.from == toApp && len(m.fromPrefix) > 0 {
+			if err := deleteDBPrefix(src, m.fromPrefix); err != nil {
+				return fmt.Errorf("failed to delete %s from %s: %w", m.desc, m.from, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// storeDBData returns the database holding the data of a store and the prefix
+// of its keys.
+func storeDBData(rootDir string, cfg config.DatabaseConfig, name string) (dbLocation, []byte) {
+	if loc, ok := storeDBLocation(rootDir, cfg, name); ok {
+		return loc, []byte(ownStorePrefix)
+	}
+
+	return applicationDBLocation(rootDir, cfg), []byte(sharedStorePrefix + name + "/")
+}
+
+// storeNames returns the sorted names of the stores held apart from the
+// application database in either configuration.
+func storeNames(configs ...config.DatabaseConfig) []string {
+	seen := make(map[string]bool)
+	for _, cfg := range configs {
+		for name := range cfg.Stores {
+			seen[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func isPrefixEmpty(db dbm.DB, prefix []byte) (bool, error) {
+	it, err := dbm.IteratePrefix(db, prefix)
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+
+	return !it.Valid(), nil
+}
+
+// copyDBPrefix copies the keys of src with the given prefix to dst, replacing
+// the prefix and skipping the keys with any of the skipped prefixes, and returns
+// the number of copied keys.
+func copyDBPrefix(src, dst dbm.DB, fromPrefix, toPrefix []byte, skip [][]byte) (n int, err error) {
+	it, err := dbm.IteratePrefix(src, fromPrefix)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	batch := dst.NewBatch()
+	defer func() { batch.Close() }()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if hasAnyPrefix(key, skip) {
+			continue
+		}
+
+		target := append(append([]byte{}, toPrefix...), key[len(fromPrefix):]...)
+		batch.Set(target, append([]byte{}, it.Value()...))
+
+		if n++; n%migrateBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return n, err
+			}
+
+			batch.Close()
+			batch = dst.NewBatch()
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return n, err
+	}
+
+	return n, batch.WriteSync()
+}
+
+// deleteDBPrefix deletes the keys of db with the given prefix.
+func deleteDBPrefix(db dbm.DB, prefix []byte) error {
+	for {
+		it, err := dbm.IteratePrefix(db, prefix)
+		if err != nil {
+			return err
+		}
+
+		var keys [][]byte
+		for ; it.Valid() && len(keys) < migrateBatchSize; it.Next() {
+			keys = append(keys, append([]byte{}, it.Key()...))
+		}
+
+		err = it.Error()
+		it.Close()
+
+		if err != nil {
+			return err
+		}
+
+		if len(keys) == 0 {
+			return nil
+		}
+
+		batch := db.NewBatch()
+		for _, key := range keys {
+			batch.Delete(key)
+		}
+
+		err = batch.WriteSync()
+		batch.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func hasAnyPrefix(key []byte, prefixes [][]byte) bool {
+	for _, prefix := range prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
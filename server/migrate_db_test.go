@@ -0,0 +1,88 @@
+package server
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func requireDBValue(t *testing.T, loc dbLocation, key, value string) {
+	db, err := loc.open()
+	require.NoError(t, err)
+	defer db.Close()
+
+	got, err := db.Get([]byte(key))
+	require.NoError(t, err)
+
+	if value == "" {
+		require.Nil(t, got)
+	} else {
+		require.Equal(t, []byte(value), got)
+	}
+}
+
+func TestMigrateDBs(t *testing.T) {
+	dir, cleanup := testutil.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	shared := config.DatabaseConfig{}
+	db, err := applicationDBLocation(dir, shared).open()
+	require.NoError(t, err)
+
+	require.NoError(t, db.Set([]byte("s/latest"), []byte("1")))
+	require.NoError(t, db.Set([]byte("s/k:bank/a"), []byte("bank")))
+	require.NoError(t, db.Set([]byte("s/k:acc/b"), []byte("acc")))
+	require.NoError(t, db.Close())
+
+	// move the bank store to its own database
+	apart := config.DatabaseConfig{
+		Stores: map[string]config.StoreDatabaseConfig{"bank": {Dir: "fast"}},
+	}
+	require.NoError(t, migrateDBs(dir, shared, apart, ioutil.Discard))
+
+	bank, ok := storeDBLocation(dir, apart, "bank")
+	require.True(t, ok)
+	require.Equal(t, filepath.Join(dir, "fast"), bank.dir)
+
+	app := applicationDBLocation(dir, apart)
+	requireDBValue(t, bank, "s/_/a", "bank")
+	requireDBValue(t, app, "s/k:bank/a", "")
+	requireDBValue(t, app, "s/k:acc/b", "acc")
+
+	// the store databases are opened from the app options
+	v := viper.New()
+	v.Set(flags.FlagHome, dir)
+	v.Set("database.stores", map[string]interface{}{"bank": map[string]interface{}{"dir": "fast"}})
+
+	dbs, err := OpenStoreDBs(v)
+	require.NoError(t, err)
+	require.Len(t, dbs, 1)
+
+	value, err := dbs["bank"].Get([]byte("s/_/a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bank"), value)
+	require.NoError(t, dbs["bank"].Close())
+
+	// nothing is migrated between identical configurations
+	require.NoError(t, migrateDBs(dir, apart, apart, ioutil.Discard))
+
+	// move the application database, holding the bank store again
+	moved := config.DatabaseConfig{Backend: string(dbm.GoLevelDBBackend), Dir: "moved"}
+	require.NoError(t, migrateDBs(dir, apart, moved, ioutil.Discard))
+
+	app = applicationDBLocation(dir, moved)
+	requireDBValue(t, app, "s/latest", "1")
+	requireDBValue(t, app, "s/k:bank/a", "bank")
+	requireDBValue(t, app, "s/k:acc/b", "acc")
+
+	// the targets must not hold the migrated data yet
+	require.Error(t, migrateDBs(dir, apart, moved, ioutil.Discard))
+}
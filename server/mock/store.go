@@ -99,6 +99,22 @@ func (ms multiStore) SetInterBlockCache(_ sdk.MultiStorePersistentCache) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetCommitWorkers(_ int) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetArchiving(_ bool) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetArchiveReset(_ bool) {
+	panic("not implemented")
+}
+
+func (ms multiStore) RollbackToVersion(_ int64) error {
+	panic("not implemented")
+}
+
 var _ sdk.KVStore = kvStore{}
 
 type kvStore struct {
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// RollbackCmd returns a command reverting the application state by one height.
+func RollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Revert the application state by one height",
+		Long: `Revert the application state to the previous height, deleting the latest one from
+the multistore and every IAVL store. The rolled back state is verified against the
+app hash of the latest block, which was computed from the previous state.
+
+The Tendermint state is rolled back to the previous height as well, while the
+latest block is kept in the block store, so that on restart the latest block is
+executed again against the rolled back application state, e.g. by a fixed binary.
+The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := openDB(config.RootDir, serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			defer closeApp(app, db)
+
+			rollbackApp, ok := app.(types.RollbackApplication)
+			if !ok {
+				return fmt.Errorf("application of type %T does not expose its multistore", app)
+			}
+
+			cms := rollbackApp.CommitMultiStore()
+			height := cms.LastCommitID().Version
+
+			if height <= 1 {
+				return fmt.Errorf("cannot roll back the application state at height %d", height)
+			}
+
+			// the app hash of a block is the one of the state preceding it
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			blockStore := tmstore.NewBlockStore(blockStoreDB)
+
+			blockMeta := blockStore.LoadBlockMeta(height)
+			if blockMeta == nil {
+				return fmt.Errorf("block %d not found in the block store", height)
+			}
+
+			// verify the rolled back state before modifying anything
+			commitID, err := rootmulti.GetCommitID(db, height-1)
+			if err != nil {
+				return fmt.Errorf("failed to get the commit of height %d: %w", height-1, err)
+			}
+
+			if !bytes.Equal(commitID.Hash, blockMeta.Header.AppHash) {
+				return fmt.Errorf(
+					"app hash %X of height %d does not match app hash %X of block %d",
+					commitID.Hash, commitID.Version, blockMeta.Header.AppHash, height,
+				)
+			}
+
+			// The Tendermint state is rolled back first: if the application state were
+			// rolled back alone, the handshake would replay the latest block and halt
+			// on an app hash differing from the one saved in the Tendermint state.
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+
+			if err := rollbackTendermintState(stateDB, blockStore, height); err != nil {
+				return fmt.Errorf("failed to roll back the Tendermint state to height %d: %w", height-1, err)
+			}
+
+			if err := cms.RollbackToVersion(height - 1); err != nil {
+				return fmt.Errorf("failed to roll back to height %d: %w", height-1, err)
+			}
+
+			cmd.Printf("Rolled back application state to height %d with app hash %X\n", commitID.Version, commitID.Hash)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// rollbackTendermintState reverts the Tendermint state saved after committing the
+// block of the given height to the one saved after the previous block. The block
+// itself is kept, so that it is executed again on restart. The state is left as
+// is if the previous block is the latest one it was saved for.
+func rollbackTendermintState(stateDB dbm.DB, blockStore *tmstore.BlockStore, height int64) error {
+	state := sm.LoadState(stateDB)
+
+	if state.LastBlockHeight == height-1 {
+		return nil
+	} else if state.LastBlockHeight != height {
+		return fmt.Errorf("unexpected Tendermint state height %d", state.LastBlockHeight)
+	}
+
+	prevMeta := blockStore.LoadBlockMeta(height - 1)
+	if prevMeta == nil {
+		return fmt.Errorf("block %d not found in the block store", height-1)
+	}
+
+	latestMeta := blockStore.LoadBlockMeta(height)
+	if latestMeta == nil {
+		return fmt.Errorf("block %d not found in the block store", height)
+	}
+
+	lastValidators, err := sm.LoadValidators(stateDB, height-1)
+	if err != nil {
+		return err
+	}
+
+	consensusParams, err := sm.LoadConsensusParams(stateDB, height)
+	if err != nil {
+		return err
+	}
+
+	// changes made by the latest block only apply after it
+	valsChanged := state.LastHeightValidatorsChanged
+	if valsChanged > height {
+		valsChanged = height
+	}
+
+	paramsChanged := state.LastHeightConsensusParamsChanged
+	if paramsChanged > height {
+		paramsChanged = height
+	}
+
+	sm.SaveState(stateDB, sm.State{
+		Version: state.Version,
+		ChainID: state.ChainID,
+
+		LastBlockHeight: height - 1,
+		LastBlockID:     prevMeta.BlockID,
+		LastBlockTime:   prevMeta.Header.Time,
+
+		NextValidators:              state.Validators,
+		Validators:                  state.LastValidators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: valsChanged,
+
+		ConsensusParams:                  consensusParams,
+		LastHeightConsensusParamsChanged: paramsChanged,
+
+		LastResultsHash: latestMeta.Header.LastResultsHash,
+		AppHash:         latestMeta.Header.AppHash,
+	})
+
+	return nil
+}
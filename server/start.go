@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"runtime/pprof"
 	"time"
@@ -17,6 +18,7 @@ import (
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	dbm "github.com/tendermint/tm-db"
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -31,18 +33,27 @@ import (
 
 // Tendermint full-node start flags
 const (
-	flagWithTendermint     = "with-tendermint"
-	flagAddress            = "address"
-	flagTransport          = "transport"
-	flagTraceStore         = "trace-store"
-	flagCPUProfile         = "cpu-profile"
-	FlagMinGasPrices       = "minimum-gas-prices"
-	FlagHaltHeight         = "halt-height"
-	FlagHaltTime           = "halt-time"
-	FlagInterBlockCache    = "inter-block-cache"
-	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
-	FlagTrace              = "trace"
-	FlagInvCheckPeriod     = "inv-check-period"
+	flagWithTendermint      = "with-tendermint"
+	flagAddress             = "address"
+	flagTransport           = "transport"
+	flagTraceStore          = "trace-store"
+	flagTraceStoreMaxSize   = "trace-store-max-size"
+	flagTraceStoreMaxFiles  = "trace-store-max-files"
+	flagCPUProfile          = "cpu-profile"
+	FlagMinGasPrices        = "minimum-gas-prices"
+	FlagHaltHeight          = "halt-height"
+	FlagHaltTime            = "halt-time"
+	FlagInterBlockCache     = "inter-block-cache"
+	FlagCommitWorkers       = "commit-workers"
+	FlagArchive             = "archive"
+	FlagArchiveReset        = "archive-reset"
+	FlagUnsafeSkipUpgrades  = "unsafe-skip-upgrades"
+	FlagTrace               = "trace"
+	FlagInvCheckPeriod      = "inv-check-period"
+	FlagFeeEstimationBlocks = "fee-estimation-blocks"
+	FlagTxTracing           = "tx-tracing"
+	FlagQueryGasLimit       = "query-gas-limit"
+	FlagQueryTimeout        = "query-timeout"
 
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
@@ -110,11 +121,20 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTransport, "socket", "Transport protocol: socket, grpc")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
+	cmd.Flags().Uint(flagTraceStoreMaxSize, 0, "Size in MiB at which the KVStore trace file is rotated; 0 disables rotation")
+	cmd.Flags().Uint(flagTraceStoreMaxFiles, 10, "Number of rotated KVStore trace files to keep")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint(FlagCommitWorkers, 1, "Number of stores committed concurrently at the end of each block")
+	cmd.Flags().Bool(FlagArchive, false, "Archive the writes of each block in a flat layout serving historical queries")
+	cmd.Flags().Bool(FlagArchiveReset, false, "Reset the archive, discarding the archived blocks, if it cannot otherwise be synced with the loaded state")
+	cmd.Flags().Uint(FlagFeeEstimationBlocks, 20, "Number of recent blocks sampled to estimate gas prices; 0 disables fee estimation")
+	cmd.Flags().Bool(FlagTxTracing, false, "Enable the gRPC query replaying committed transactions to trace their execution")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Gas limit of each gRPC and legacy query; 0 is unlimited")
+	cmd.Flags().Duration(FlagQueryTimeout, 0, "Wall-clock timeout of each gRPC and legacy query (e.g. 10s); 0 is unlimited")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
@@ -133,13 +153,17 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	transport := ctx.Viper.GetString(flagTransport)
 	home := ctx.Viper.GetString(flags.FlagHome)
 
-	db, err := openDB(home)
+	db, err := openDB(home, ctx.Viper)
 	if err != nil {
 		return err
 	}
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
-	traceWriter, err := openTraceWriter(traceWriterFile)
+	traceWriter, err := openTraceWriter(
+		traceWriterFile,
+		int64(ctx.Viper.GetUint(flagTraceStoreMaxSize))<<20,
+		ctx.Viper.GetInt(flagTraceStoreMaxFiles),
+	)
 	if err != nil {
 		return err
 	}
@@ -162,6 +186,10 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		if err := closeApp(app, db); err != nil {
+			ctx.Logger.Error("failed to close the application databases", "err", err)
+		}
 	})
 
 	// run forever (the node will not be returned)
@@ -173,12 +201,16 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator types.AppC
 	home := cfg.RootDir
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
-	db, err := openDB(home)
+	db, err := openDB(home, ctx.Viper)
 	if err != nil {
 		return err
 	}
 
-	traceWriter, err := openTraceWriter(traceWriterFile)
+	traceWriter, err := openTraceWriter(
+		traceWriterFile,
+		int64(ctx.Viper.GetUint(flagTraceStoreMaxSize))<<20,
+		ctx.Viper.GetInt(flagTraceStoreMaxFiles),
+	)
 	if err != nil {
 		return err
 	}
@@ -287,9 +319,27 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator types.AppC
 			grpcSrv.Stop()
 		}
 
+		if err := closeApp(app, db); err != nil {
+			ctx.Logger.Error("failed to close the application databases", "err", err)
+		}
+
 		ctx.Logger.Info("exiting...")
 	})
 
 	// run forever (the node will not be returned)
 	select {}
 }
+
+// closeApp closes the app, if it holds databases of its own such as the ones of
+// the stores set with the baseapp.SetStoreDBs option, and the application
+// database.
+func closeApp(app types.Application, db dbm.DB) error {
+	if closer, ok := app.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			db.Close()
+			return err
+		}
+	}
+
+	return db.Close()
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// rotatingWriter appends to a file which it rotates once a write would make it
+// exceed a maximum size. Rotating renames the file with the suffix ".1", after
+// shifting the previously rotated files to the next suffix, and keeps at most
+// a given number of rotated files.
+type rotatingWriter struct {
+	mtx sync.Mutex
+
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	file *os.File
+	size int64
+}
+
+func newRotatingWriter(path string, maxSize int64, maxFiles int) (*rotatingWriter, error) {
+	w := &rotatingWriter{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Write implements io.Writer. A single write is never split across files.
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+
+	return n, err
+}
+
+// Close closes the current file.
+func (w *rotatingWriter) Close() error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.file.Close()
+}
+
+func (w *rotatingWriter) open() error {
+	file, err := os.OpenFile(w.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	w.file, w.size = file, info.Size()
+
+	return nil
+}
+
+func (w *rotatingWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	if w.maxFiles > 0 {
+		if err := os.Remove(w.rotatedPath(w.maxFiles)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		for i := w.maxFiles - 1; i > 0; i-- {
+			if err := os.Rename(w.rotatedPath(i), w.rotatedPath(i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		if err := os.Rename(w.path, w.rotatedPath(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(w.path); err != nil {
+		return err
+	}
+
+	return w.open()
+}
+
+func (w *rotatingWriter) rotatedPath(i int) string {
+	return fmt.Sprintf("%s.%d", w.path, i)
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func requireFileContent(t *testing.T, path, content string) {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, content, string(bz))
+}
+
+func TestRotatingWriter(t *testing.T) {
+	dir, cleanup := testutil.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	path := filepath.Join(dir, "trace.log")
+	require.NoError(t, ioutil.WriteFile(path, []byte("0000\n"), 0600))
+
+	w, err := newRotatingWriter(path, 10, 2)
+	require.NoError(t, err)
+
+	// the existing content counts towards the size of the file
+	for _, line := range []string{"1111\n", "2222\n", "3333\n", "4444\n", "5555555555555\n"} {
+		n, err := w.Write([]byte(line))
+		require.NoError(t, err)
+		require.Equal(t, len(line), n)
+	}
+	require.NoError(t, w.Close())
+
+	// a write larger than the maximum size is not split
+	requireFileContent(t, path, "5555555555555\n")
+	requireFileContent(t, path+".1", "4444\n")
+	requireFileContent(t, path+".2", "2222\n3333\n")
+	require.NoFileExists(t, path+".3")
+
+	// without rotated files to keep, the file is truncated
+	w, err = newRotatingWriter(path, 10, 0)
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("6666\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	requireFileContent(t, path, "6666\n")
+	requireFileContent(t, path+".1", "4444\n")
+}
@@ -11,6 +11,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/server/api"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type (
@@ -38,11 +39,41 @@ type (
 		RegisterGRPCServer(grpc.Server)
 	}
 
+	// RollbackApplication is an Application exposing its root multistore, for
+	// offline maintenance of its state, as required by the rollback command.
+	RollbackApplication interface {
+		Application
+
+		// CommitMultiStore returns the root multistore of the application.
+		CommitMultiStore() sdk.CommitMultiStore
+	}
+
 	// AppCreator is a function that allows us to lazily initialize an
 	// application using various configurations.
 	AppCreator func(log.Logger, dbm.DB, io.Writer, AppOptions) Application
 
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
+	//
+	// Deprecated: use AppExporterWithOptions, which is passed the app options,
+	// e.g. to open the databases of the stores configured in app.toml.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error)
+
+	// AppExporterWithOptions is an AppExporter which is also passed the app
+	// options, as the AppCreator is.
+	AppExporterWithOptions func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error)
 )
+
+// WithOptions returns the exporter as an AppExporterWithOptions ignoring the
+// app options, or nil if it is nil.
+func (e AppExporter) WithOptions() AppExporterWithOptions {
+	if e == nil {
+		return nil
+	}
+
+	return func(
+		logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string, _ AppOptions,
+	) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error) {
+		return e(logger, db, traceStore, height, forZeroHeight, jailWhiteList)
+	}
+}
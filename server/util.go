@@ -18,7 +18,6 @@ import (
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	tmflags "github.com/tendermint/tendermint/libs/cli/flags"
 	"github.com/tendermint/tendermint/libs/log"
-	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -169,7 +168,15 @@ func interceptConfigs(ctx *Context, rootViper *viper.Viper) (*tmcfg.Config, erro
 }
 
 // add server commands
+//
+// Deprecated: use AddCommandsWithOptions.
 func AddCommands(rootCmd *cobra.Command, appCreator types.AppCreator, appExport types.AppExporter) {
+	AddCommandsWithOptions(rootCmd, appCreator, appExport.WithOptions())
+}
+
+// AddCommandsWithOptions adds the server commands, passing the app options to
+// the exporter.
+func AddCommandsWithOptions(rootCmd *cobra.Command, appCreator types.AppCreator, appExport types.AppExporterWithOptions) {
 	tendermintCmd := &cobra.Command{
 		Use:   "tendermint",
 		Short: "Tendermint subcommands",
@@ -185,9 +192,11 @@ func AddCommands(rootCmd *cobra.Command, appCreator types.AppCreator, appExport
 	rootCmd.AddCommand(
 		StartCmd(appCreator, simapp.DefaultNodeHome),
 		UnsafeResetAllCmd(),
+		RollbackCmd(appCreator, simapp.DefaultNodeHome),
+		MigrateDBCmd(simapp.DefaultNodeHome),
 		flags.LineBreak,
 		tendermintCmd,
-		ExportCmd(appExport, simapp.DefaultNodeHome),
+		ExportCmdWithOptions(appExport, simapp.DefaultNodeHome),
 		flags.LineBreak,
 		version.NewVersionCommand(),
 	)
@@ -291,15 +300,18 @@ func addrToIP(addr net.Addr) net.IP {
 	return ip
 }
 
-func openDB(rootDir string) (dbm.DB, error) {
-	dataDir := filepath.Join(rootDir, "data")
-	return sdk.NewLevelDB("application", dataDir)
-}
-
-func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
+// openTraceWriter opens the file store operations are traced to, if any. If a
+// maximum size is given, the file is rotated once it is reached, keeping the
+// given number of rotated files.
+func openTraceWriter(traceWriterFile string, maxSize int64, maxFiles int) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
 	}
+
+	if maxSize > 0 {
+		return newRotatingWriter(traceWriterFile, maxSize, maxFiles)
+	}
+
 	return os.OpenFile(
 		traceWriterFile,
 		os.O_WRONLY|os.O_APPEND|os.O_CREATE,
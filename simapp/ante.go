@@ -0,0 +1,33 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	circuitante "github.com/cosmos/cosmos-sdk/x/circuit/ante"
+	feemarketante "github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+)
+
+// NewAnteHandler returns the AnteHandler of the SimApp, which is the one of the
+// fee market in which the CircuitBreakerDecorator rejects the txs including a
+// disabled message before their signatures are verified and their fees deducted.
+func NewAnteHandler(
+	ak authante.AccountKeeper, bankKeeper authtypes.BankKeeper, fmk feemarketante.FeeMarketKeeper,
+	cb circuitante.CircuitBreaker, sigGasConsumer authante.SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		circuitante.NewCircuitBreakerDecorator(cb),
+		authante.NewValidateBasicDecorator(),
+		authante.NewValidateMemoDecorator(ak),
+		authante.NewConsumeGasForTxSizeDecorator(ak),
+		authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewValidateSigCountDecorator(ak),
+		feemarketante.NewDeductFeeDecorator(ak, bankKeeper, fmk),
+		authante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		authante.NewSigVerificationDecorator(ak, signModeHandler),
+		authante.NewIncrementSequenceDecorator(ak),
+	)
+}
@@ -16,15 +16,18 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/feeestimate"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -37,7 +40,12 @@ var (
 		Use:   "simd",
 		Short: "simulation app",
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
-			if err := client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
+			clientCtx, err := config.ReadFromClientConfig(cmd, initClientCtx)
+			if err != nil {
+				return err
+			}
+
+			if err := client.SetCmdClientContextHandler(clientCtx, cmd); err != nil {
 				return err
 			}
 
@@ -75,6 +83,8 @@ func Execute() error {
 func init() {
 	authclient.Codec = encodingConfig.Marshaler
 
+	rootCmd.PersistentFlags().String(flags.FlagProfile, "", "Profile of the client configuration (client.toml) to use")
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(simapp.ModuleBasics, simapp.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
@@ -84,10 +94,11 @@ func init() {
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
 		cli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCommand(),
+		config.Cmd(),
 	)
 
-	server.AddCommands(rootCmd, newApp, exportAppStateAndTMValidators)
+	server.AddCommandsWithOptions(rootCmd, newApp, exportAppStateAndTMValidators)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -98,6 +109,18 @@ func init() {
 	)
 }
 
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+
+	cmd.AddCommand(debug.StoreDiffCmd(func() sdk.StoreDecoderRegistry {
+		app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, map[int64]bool{}, "", 0)
+		return app.SimulationManager().StoreDecoders
+	}))
+	cmd.AddCommand(debug.ReplayTraceCmd())
+
+	return cmd
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -138,6 +161,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetValidateSignaturesCommand(),
 		flags.LineBreak,
 		authcmd.GetBroadcastCommand(),
+		authcmd.GetSubmitCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		flags.LineBreak,
@@ -153,7 +177,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	var cache sdk.MultiStorePersistentCache
 
 	if cast.ToBool(appOpts.Get(server.FlagInterBlockCache)) {
-		cache = store.NewCommitKVStoreCacheManager()
+		cache = store.NewCommitKVStoreCacheManagerWithLimits(serverconfig.GetStoreCacheConfig(appOpts).Limits())
 	}
 
 	skipUpgradeHeights := make(map[int64]bool)
@@ -166,32 +190,56 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		panic(err)
 	}
 
-	return simapp.NewSimApp(
-		logger, db, traceStore, true, skipUpgradeHeights,
-		cast.ToString(appOpts.Get(flags.FlagHome)),
-		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
+	storeDBs, err := server.OpenStoreDBs(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	baseappOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
+		baseapp.SetStoreDBs(storeDBs),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
 		baseapp.SetInterBlockCache(cache),
+		baseapp.SetCommitWorkers(cast.ToInt(appOpts.Get(server.FlagCommitWorkers))),
+		baseapp.SetArchiving(cast.ToBool(appOpts.Get(server.FlagArchive))),
+		baseapp.SetArchiveReset(cast.ToBool(appOpts.Get(server.FlagArchiveReset))),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
+		baseapp.SetTxTracing(cast.ToBool(appOpts.Get(server.FlagTxTracing))),
+		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(server.FlagQueryGasLimit))),
+		baseapp.SetQueryTimeout(cast.ToDuration(appOpts.Get(server.FlagQueryTimeout))),
+	}
+
+	if blocks := cast.ToInt(appOpts.Get(server.FlagFeeEstimationBlocks)); blocks > 0 {
+		baseappOptions = append(baseappOptions, baseapp.SetFeeOracle(feeestimate.NewOracle(blocks)))
+	}
+
+	return simapp.NewSimApp(
+		logger, db, traceStore, true, skipUpgradeHeights,
+		cast.ToString(appOpts.Get(flags.FlagHome)),
+		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
+		baseappOptions...,
 	)
 }
 
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string,
+	appOpts servertypes.AppOptions,
 ) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error) {
 
-	var simApp *simapp.SimApp
-	if height != -1 {
-		simApp = simapp.NewSimApp(logger, db, traceStore, false, map[int64]bool{}, "", uint(1))
+	storeDBs, err := server.OpenStoreDBs(appOpts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	simApp := simapp.NewSimApp(logger, db, traceStore, height == -1, map[int64]bool{}, "", uint(1), baseapp.SetStoreDBs(storeDBs))
+	defer simApp.Close()
 
+	if height != -1 {
 		if err := simApp.LoadHeight(height); err != nil {
 			return nil, nil, nil, err
 		}
-	} else {
-		simApp = simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, "", uint(1))
 	}
 
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// arcCache is a thread-safe adaptive replacement cache holding at most a given
+// number of entries. It does not track the memory taken by the entries.
+type arcCache struct {
+	cache *lru.ARCCache
+}
+
+func newARCCache(size uint) *arcCache {
+	cache, err := lru.NewARC(int(size))
+	if err != nil {
+		panic(fmt.Errorf("failed to create KVStore cache: %s", err))
+	}
+
+	return &arcCache{cache: cache}
+}
+
+func (c *arcCache) get(key string) ([]byte, bool) {
+	value, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	return value.([]byte), true
+}
+
+// add caches the value of a key and returns the number of entries evicted,
+// i.e. one if a new key was added to a full cache.
+func (c *arcCache) add(key string, value []byte) (evicted int) {
+	cached, entries := c.cache.Contains(key), c.cache.Len()
+	c.cache.Add(key, value)
+
+	if !cached && c.cache.Len() == entries {
+		return 1
+	}
+
+	return 0
+}
+
+func (c *arcCache) remove(key string) {
+	c.cache.Remove(key)
+}
+
+func (c *arcCache) stats() (entries int, bytes uint64) {
+	return c.cache.Len(), 0
+}
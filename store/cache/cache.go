@@ -1,33 +1,57 @@
 package cache
 
 import (
-	"fmt"
+	"io"
+	"sync/atomic"
+
+	metrics "github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
-
-	lru "github.com/hashicorp/golang-lru"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 var (
 	_ types.CommitKVStore             = (*CommitKVStoreCache)(nil)
 	_ types.MultiStorePersistentCache = (*CommitKVStoreCacheManager)(nil)
 
-	// DefaultCommitKVStoreCacheSize defines the persistent ARC cache size for a
+	// DefaultCommitKVStoreCacheSize defines the maximum number of entries of a
 	// CommitKVStoreCache.
 	DefaultCommitKVStoreCacheSize uint = 1000
+
+	// DefaultCommitKVStoreCacheMaxBytes defines the maximum memory, in bytes,
+	// taken by the entries of a CommitKVStoreCache.
+	DefaultCommitKVStoreCacheMaxBytes uint64 = 32 << 20
 )
 
 type (
 	// CommitKVStoreCache implements an inter-block (persistent) cache that wraps a
-	// CommitKVStore. Reads first hit the internal ARC (Adaptive Replacement Cache).
+	// CommitKVStore. Reads first hit the internal cache, either an ARC (Adaptive
+	// Replacement Cache) of a given size or an LRU cache bounded by Limits.
 	// During a cache miss, the read is delegated to the underlying CommitKVStore
 	// and cached. Deletes and writes always happen to both the cache and the
 	// CommitKVStore in a write-through manner. Caching performed in the
 	// CommitKVStore and below is completely irrelevant to this layer.
+	//
+	// Hits, misses and evictions are counted, and emitted as telemetry counters
+	// labeled with the name of the store.
 	CommitKVStoreCache struct {
 		types.CommitKVStore
-		cache *lru.ARCCache
+		cache  kvCache
+		labels []metrics.Label
+
+		hits, misses, evictions uint64
+	}
+
+	// CacheStats defines the counters and the size of a CommitKVStoreCache. The
+	// memory taken by the entries is only tracked by caches bounded by Limits.
+	CacheStats struct {
+		Hits      uint64
+		Misses    uint64
+		Evictions uint64
+		Entries   int
+		Bytes     uint64
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -35,41 +59,93 @@ type (
 	// in an inter-block (persistent) manner and typically provided by a
 	// CommitMultiStore.
 	CommitKVStoreCacheManager struct {
-		cacheSize uint
-		caches    map[string]types.CommitKVStore
+		cacheSize   uint
+		limits      Limits
+		storeLimits map[string]Limits
+		caches      map[string]types.CommitKVStore
+	}
+
+	// kvCache is the internal cache of a CommitKVStoreCache.
+	kvCache interface {
+		get(key string) ([]byte, bool)
+		add(key string, value []byte) (evicted int)
+		remove(key string)
+		stats() (entries int, bytes uint64)
 	}
 )
 
+// NewCommitKVStoreCache returns an inter-block cache wrapping the given store
+// with an ARC cache of the given size, which must be positive.
 func NewCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreCache {
-	cache, err := lru.NewARC(int(size))
-	if err != nil {
-		panic(fmt.Errorf("failed to create KVStore cache: %s", err))
+	return newCommitKVStoreCache(store, "", newARCCache(size))
+}
+
+// NewCommitKVStoreCacheWithLimits returns an inter-block cache wrapping the
+// store of the given name with an LRU cache bounded by the given limits.
+func NewCommitKVStoreCacheWithLimits(store types.CommitKVStore, name string, limits Limits) *CommitKVStoreCache {
+	return newCommitKVStoreCache(store, name, newLRUCache(limits))
+}
+
+func newCommitKVStoreCache(store types.CommitKVStore, name string, cache kvCache) *CommitKVStoreCache {
+	var labels []metrics.Label
+	if name != "" {
+		labels = []metrics.Label{telemetry.NewLabel("store", name)}
 	}
 
 	return &CommitKVStoreCache{
 		CommitKVStore: store,
 		cache:         cache,
+		labels:        labels,
 	}
 }
 
+// NewCommitKVStoreCacheManager returns a manager of ARC caches holding at most
+// the given number of entries each. It panics if the size is zero.
 func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
+	if size == 0 {
+		panic("inter-block cache size must be positive")
+	}
+
 	return &CommitKVStoreCacheManager{
 		cacheSize: size,
 		caches:    make(map[string]types.CommitKVStore),
 	}
 }
 
+// NewCommitKVStoreCacheManagerWithLimits returns a manager of LRU caches bounded
+// by the given limits, which may be overridden per store name.
+func NewCommitKVStoreCacheManagerWithLimits(limits Limits, storeLimits map[string]Limits) *CommitKVStoreCacheManager {
+	return &CommitKVStoreCacheManager{
+		limits:      limits,
+		storeLimits: storeLimits,
+		caches:      make(map[string]types.CommitKVStore),
+	}
+}
+
 // GetStoreCache returns a Cache from the CommitStoreCacheManager for a given
 // StoreKey. If no Cache exists for the StoreKey, then one is created and set.
 // The returned Cache is meant to be used in a persistent manner.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		cmgr.caches[key.Name()] = cmgr.newStoreCache(key.Name(), store)
 	}
 
 	return cmgr.caches[key.Name()]
 }
 
+func (cmgr *CommitKVStoreCacheManager) newStoreCache(name string, store types.CommitKVStore) *CommitKVStoreCache {
+	if cmgr.cacheSize > 0 {
+		return newCommitKVStoreCache(store, name, newARCCache(cmgr.cacheSize))
+	}
+
+	limits, ok := cmgr.storeLimits[name]
+	if !ok {
+		limits = cmgr.limits
+	}
+
+	return NewCommitKVStoreCacheWithLimits(store, name, limits)
+}
+
 // Unwrap returns the underlying CommitKVStore for a given StoreKey.
 func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKVStore {
 	if ckv, ok := cmgr.caches[key.Name()]; ok {
@@ -79,6 +155,16 @@ func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKV
 	return nil
 }
 
+// Stats returns the statistics of the cache of each store, by store name.
+func (cmgr *CommitKVStoreCacheManager) Stats() map[string]CacheStats {
+	stats := make(map[string]CacheStats, len(cmgr.caches))
+	for name, ckv := range cmgr.caches {
+		stats[name] = ckv.(*CommitKVStoreCache).Stats()
+	}
+
+	return stats
+}
+
 // Reset resets in the internal caches.
 func (cmgr *CommitKVStoreCacheManager) Reset() {
 	// Clear the map.
@@ -89,11 +175,30 @@ func (cmgr *CommitKVStoreCacheManager) Reset() {
 	}
 }
 
+// Stats returns the counters and the current size of the cache.
+func (ckv *CommitKVStoreCache) Stats() CacheStats {
+	entries, bytes := ckv.cache.stats()
+
+	return CacheStats{
+		Hits:      atomic.LoadUint64(&ckv.hits),
+		Misses:    atomic.LoadUint64(&ckv.misses),
+		Evictions: atomic.LoadUint64(&ckv.evictions),
+		Entries:   entries,
+		Bytes:     bytes,
+	}
+}
+
 // CacheWrap returns the inter-block cache as a cache-wrapped CommitKVStore.
 func (ckv *CommitKVStoreCache) CacheWrap() types.CacheWrap {
 	return cachekv.NewStore(ckv)
 }
 
+// CacheWrapWithTrace returns the inter-block cache as a cache-wrapped
+// CommitKVStore with tracing enabled.
+func (ckv *CommitKVStoreCache) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(ckv, w, tc))
+}
+
 // Get retrieves a value by key. It will first look in the write-through cache.
 // If the value doesn't exist in the write-through cache, the query is delegated
 // to the underlying CommitKVStore.
@@ -101,15 +206,21 @@ func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	types.AssertValidKey(key)
 
 	keyStr := string(key)
-	valueI, ok := ckv.cache.Get(keyStr)
+	value, ok := ckv.cache.get(keyStr)
 	if ok {
 		// cache hit
-		return valueI.([]byte)
+		atomic.AddUint64(&ckv.hits, 1)
+		telemetry.IncrCounterWithLabels([]string{"store", "cache", "hit"}, 1, ckv.labels)
+
+		return value
 	}
 
 	// cache miss; write to cache
-	value := ckv.CommitKVStore.Get(key)
-	ckv.cache.Add(keyStr, value)
+	atomic.AddUint64(&ckv.misses, 1)
+	telemetry.IncrCounterWithLabels([]string{"store", "cache", "miss"}, 1, ckv.labels)
+
+	value = ckv.CommitKVStore.Get(key)
+	ckv.add(keyStr, value)
 
 	return value
 }
@@ -120,13 +231,20 @@ func (ckv *CommitKVStoreCache) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 
-	ckv.cache.Add(string(key), value)
+	ckv.add(string(key), value)
 	ckv.CommitKVStore.Set(key, value)
 }
 
 // Delete removes a key/value pair from both the write-through cache and the
 // underlying CommitKVStore.
 func (ckv *CommitKVStoreCache) Delete(key []byte) {
-	ckv.cache.Remove(string(key))
+	ckv.cache.remove(string(key))
 	ckv.CommitKVStore.Delete(key)
 }
+
+func (ckv *CommitKVStoreCache) add(key string, value []byte) {
+	if evicted := ckv.cache.add(key, value); evicted > 0 {
+		atomic.AddUint64(&ckv.evictions, uint64(evicted))
+		telemetry.IncrCounterWithLabels([]string{"store", "cache", "eviction"}, float32(evicted), ckv.labels)
+	}
+}
@@ -67,3 +67,82 @@ func TestStoreCache(t *testing.T) {
 		require.Nil(t, store.Get(key))
 	}
 }
+
+func TestStoreCacheLimits(t *testing.T) {
+	db := dbm.NewMemDB()
+	mngr := cache.NewCommitKVStoreCacheManagerWithLimits(
+		cache.Limits{MaxEntries: 10},
+		map[string]cache.Limits{"bounded": {MaxBytes: 1000}},
+	)
+
+	tree, err := iavl.NewMutableTree(db, 100)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+
+	// the default limits bound the number of entries
+	kvStore := mngr.GetStoreCache(types.NewKVStoreKey("test"), store).(*cache.CommitKVStoreCache)
+	for i := 0; i < 20; i++ {
+		kvStore.Set([]byte(fmt.Sprintf("key_%d", i)), []byte("value"))
+	}
+
+	stats := kvStore.Stats()
+	require.Equal(t, 10, stats.Entries)
+	require.Equal(t, uint64(10), stats.Evictions)
+
+	// the least recently used entries are evicted
+	require.Equal(t, []byte("value"), kvStore.Get([]byte("key_0")))
+	require.Equal(t, []byte("value"), kvStore.Get([]byte("key_19")))
+	require.Nil(t, kvStore.Get([]byte("missing")))
+
+	stats = kvStore.Stats()
+	require.Equal(t, uint64(1), stats.Hits)
+	require.Equal(t, uint64(2), stats.Misses)
+	require.Equal(t, uint64(12), stats.Evictions)
+	require.Equal(t, 10, stats.Entries)
+
+	// overridden limits bound the memory taken by the entries
+	bounded := mngr.GetStoreCache(types.NewKVStoreKey("bounded"), store).(*cache.CommitKVStoreCache)
+	for i := 0; i < 20; i++ {
+		bounded.Set([]byte(fmt.Sprintf("key_%d", i)), make([]byte, 100))
+	}
+
+	stats = bounded.Stats()
+	require.True(t, stats.Bytes <= 1000)
+	require.True(t, stats.Entries > 0 && stats.Entries < 20)
+	require.Equal(t, uint64(20-stats.Entries), stats.Evictions)
+
+	// entries larger than the bound are not cached, but still written
+	bounded.Set([]byte("large"), make([]byte, 2000))
+	require.Len(t, store.Get([]byte("large")), 2000)
+	require.Equal(t, stats.Entries, bounded.Stats().Entries)
+
+	require.Len(t, mngr.Stats(), 2)
+	require.Equal(t, bounded.Stats(), mngr.Stats()["bounded"])
+}
+
+func TestStoreCacheARC(t *testing.T) {
+	require.Panics(t, func() { cache.NewCommitKVStoreCacheManager(0) })
+
+	db := dbm.NewMemDB()
+	tree, err := iavl.NewMutableTree(db, 100)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+
+	kvStore := cache.NewCommitKVStoreCache(store, 10)
+	for i := 0; i < 20; i++ {
+		kvStore.Set([]byte(fmt.Sprintf("key_%d", i)), []byte("value"))
+	}
+
+	stats := kvStore.Stats()
+	require.Equal(t, 10, stats.Entries)
+	require.Equal(t, uint64(10), stats.Evictions)
+
+	// updating a cached key evicts nothing
+	kvStore.Set([]byte("key_19"), []byte("value2"))
+	require.Equal(t, []byte("value2"), kvStore.Get([]byte("key_19")))
+
+	stats = kvStore.Stats()
+	require.Equal(t, uint64(1), stats.Hits)
+	require.Equal(t, uint64(10), stats.Evictions)
+	require.Equal(t, 10, stats.Entries)
+}
@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// entryOverhead estimates the memory, in bytes, taken by a cached entry besides
+// its key and value, i.e. its list element and map bucket.
+const entryOverhead = 96
+
+// Limits defines the bounds of an inter-block cache. Once either is exceeded,
+// the least recently used entries are evicted. A zero bound is unlimited.
+type Limits struct {
+	// MaxEntries defines the maximum number of cached entries.
+	MaxEntries uint
+
+	// MaxBytes defines the maximum memory, in bytes, taken by the cached
+	// entries, as estimated from the sizes of their keys and values.
+	MaxBytes uint64
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+func (e *lruEntry) size() uint64 {
+	return uint64(len(e.key)+len(e.value)) + entryOverhead
+}
+
+// lruCache is a thread-safe least recently used cache bounded by Limits.
+type lruCache struct {
+	mtx    sync.Mutex
+	limits Limits
+
+	entries *list.List // most recently used first
+	items   map[string]*list.Element
+	bytes   uint64
+}
+
+func newLRUCache(limits Limits) *lruCache {
+	return &lruCache{
+		limits:  limits,
+		entries: list.New(),
+		items:   make(map[string]*list.Element),
+	}
+}
+
+// get returns the value of a cached key and marks it as recently used.
+func (c *lruCache) get(key string) ([]byte, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.entries.MoveToFront(elem)
+
+	return elem.Value.(*lruEntry).value, true
+}
+
+// add caches the value of a key and returns the number of entries evicted to
+// stay within the limits. An entry larger than the memory bound is not cached.
+func (c *lruCache) add(key string, value []byte) (evicted int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.removeElement(c.items[key])
+
+	entry := &lruEntry{key: key, value: value}
+	if c.limits.MaxBytes > 0 && entry.size() > c.limits.MaxBytes {
+		return 0
+	}
+
+	c.items[key] = c.entries.PushFront(entry)
+	c.bytes += entry.size()
+
+	for c.exceeded() {
+		c.removeElement(c.entries.Back())
+		evicted++
+	}
+
+	return evicted
+}
+
+// remove removes a key from the cache.
+func (c *lruCache) remove(key string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.removeElement(c.items[key])
+}
+
+// stats returns the number of cached entries and the memory they take.
+func (c *lruCache) stats() (entries int, bytes uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.entries.Len(), c.bytes
+}
+
+func (c *lruCache) exceeded() bool {
+	return (c.limits.MaxEntries > 0 && uint(c.entries.Len()) > c.limits.MaxEntries) ||
+		(c.limits.MaxBytes > 0 && c.bytes > c.limits.MaxBytes)
+}
+
+func (c *lruCache) removeElement(elem *list.Element) {
+	if elem == nil {
+		return
+	}
+
+	entry := c.entries.Remove(elem).(*lruEntry)
+	delete(c.items, entry.key)
+	c.bytes -= entry.size()
+}
@@ -1,46 +1,108 @@
 package cachekv
 
 import (
-	"container/list"
+	"bytes"
 	"errors"
 
-	tmkv "github.com/tendermint/tendermint/libs/kv"
-	dbm "github.com/tendermint/tm-db"
+	"github.com/google/btree"
 )
 
-// Iterates over iterKVCache items.
-// if key is nil, means it was deleted.
+// item is an entry of the sorted cache of dirty items.
+// if value is nil, means it was deleted.
+type item struct {
+	key   []byte
+	value []byte
+}
+
+var _ btree.Item = item{}
+
+// Less implements btree.Item by ordering items by key.
+func (i item) Less(than btree.Item) bool {
+	return bytes.Compare(i.key, than.(item).key) < 0
+}
+
+// Iterates over the items of a snapshot of the sorted cache.
+// if value is nil, means it was deleted.
 // Implements Iterator.
+//
+// Items are looked up lazily in batches of memIteratorBatchSize, so that
+// creating the iterator is logarithmic in the number of cached items rather
+// than linear in the number of items in its domain.
 type memIterator struct {
 	start, end []byte
-	items      []*tmkv.Pair
+	items      *btree.BTree
 	ascending  bool
+
+	// buffer holds the next items in the direction of iteration and pos the
+	// index of the current one; pos is past the end once the iterator is
+	// exhausted
+	buffer []item
+	pos    int
+}
+
+// memIteratorBatchSize is the number of items a memIterator looks up at once.
+const memIteratorBatchSize = 64
+
+func newMemIterator(start, end []byte, items *btree.BTree, ascending bool) *memIterator {
+	mi := &memIterator{
+		start:     start,
+		end:       end,
+		items:     items,
+		ascending: ascending,
+	}
+
+	if ascending {
+		mi.seek(start, true)
+	} else {
+		mi.seek(end, false)
+	}
+
+	return mi
 }
 
-func newMemIterator(start, end []byte, items *list.List, ascending bool) *memIterator {
-	itemsInDomain := make([]*tmkv.Pair, 0)
+// seek fills the buffer with the first items in the iterator's domain
+// following pivot, in the direction of iteration. A nil pivot stands for the
+// beginning of the domain.
+func (mi *memIterator) seek(pivot []byte, inclusive bool) {
+	mi.buffer = mi.buffer[:0]
+	mi.pos = 0
 
-	var entered bool
+	// only the first visited item may be the pivot itself
+	first := true
 
-	for e := items.Front(); e != nil; e = e.Next() {
-		item := e.Value.(*tmkv.Pair)
-		if !dbm.IsKeyInDomain(item.Key, start, end) {
-			if entered {
-				break
+	visit := func(i btree.Item) bool {
+		it := i.(item)
+
+		if first {
+			first = false
+
+			if !inclusive && pivot != nil && bytes.Equal(it.key, pivot) {
+				return true
 			}
+		}
+
+		if mi.ascending && mi.end != nil && bytes.Compare(it.key, mi.end) >= 0 {
+			return false
+		}
 
-			continue
+		if !mi.ascending && mi.start != nil && bytes.Compare(it.key, mi.start) < 0 {
+			return false
 		}
 
-		itemsInDomain = append(itemsInDomain, item)
-		entered = true
+		mi.buffer = append(mi.buffer, it)
+
+		return len(mi.buffer) < memIteratorBatchSize
 	}
 
-	return &memIterator{
-		start:     start,
-		end:       end,
-		items:     itemsInDomain,
-		ascending: ascending,
+	switch {
+	case mi.ascending && pivot == nil:
+		mi.items.Ascend(visit)
+	case mi.ascending:
+		mi.items.AscendGreaterOrEqual(item{key: pivot}, visit)
+	case pivot == nil:
+		mi.items.Descend(visit)
+	default:
+		mi.items.DescendLessOrEqual(item{key: pivot}, visit)
 	}
 }
 
@@ -49,7 +111,7 @@ func (mi *memIterator) Domain() ([]byte, []byte) {
 }
 
 func (mi *memIterator) Valid() bool {
-	return len(mi.items) > 0
+	return mi.pos < len(mi.buffer)
 }
 
 func (mi *memIterator) assertValid() {
@@ -61,37 +123,28 @@ func (mi *memIterator) assertValid() {
 func (mi *memIterator) Next() {
 	mi.assertValid()
 
-	if mi.ascending {
-		mi.items = mi.items[1:]
-	} else {
-		mi.items = mi.items[:len(mi.items)-1]
+	if mi.pos++; mi.pos < len(mi.buffer) {
+		return
 	}
+
+	mi.seek(mi.buffer[len(mi.buffer)-1].key, false)
 }
 
 func (mi *memIterator) Key() []byte {
 	mi.assertValid()
-
-	if mi.ascending {
-		return mi.items[0].Key
-	}
-
-	return mi.items[len(mi.items)-1].Key
+	return mi.buffer[mi.pos].key
 }
 
 func (mi *memIterator) Value() []byte {
 	mi.assertValid()
-
-	if mi.ascending {
-		return mi.items[0].Value
-	}
-
-	return mi.items[len(mi.items)-1].Value
+	return mi.buffer[mi.pos].value
 }
 
 func (mi *memIterator) Close() {
 	mi.start = nil
 	mi.end = nil
 	mi.items = nil
+	mi.buffer = nil
 }
 
 // Error returns an error if the memIterator is invalid defined by the Valid
@@ -1,15 +1,11 @@
 package cachekv
 
 import (
-	"bytes"
-	"container/list"
 	"io"
-	"sort"
 	"sync"
 	"time"
 
-	tmkv "github.com/tendermint/tendermint/libs/kv"
-	dbm "github.com/tendermint/tm-db"
+	"github.com/google/btree"
 
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -24,23 +20,24 @@ type cValue struct {
 	dirty   bool
 }
 
+// sortedCacheDegree is the degree of the B-tree holding the dirty items.
+const sortedCacheDegree = 32
+
 // Store wraps an in-memory cache around an underlying types.KVStore.
 type Store struct {
-	mtx           sync.Mutex
-	cache         map[string]*cValue
-	unsortedCache map[string]struct{}
-	sortedCache   *list.List // always ascending sorted
-	parent        types.KVStore
+	mtx         sync.Mutex
+	cache       map[string]*cValue
+	sortedCache *btree.BTree // dirty items, sorted by key
+	parent      types.KVStore
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
 
 func NewStore(parent types.KVStore) *Store {
 	return &Store{
-		cache:         make(map[string]*cValue),
-		unsortedCache: make(map[string]struct{}),
-		sortedCache:   list.New(),
-		parent:        parent,
+		cache:       make(map[string]*cValue),
+		sortedCache: btree.New(sortedCacheDegree),
+		parent:      parent,
 	}
 }
 
@@ -102,37 +99,28 @@ func (store *Store) Write() {
 	defer store.mtx.Unlock()
 	defer telemetry.MeasureSince(time.Now(), "store", "cachekv", "write")
 
-	// We need a copy of all of the keys.
-	// Not the best, but probably not a bottleneck depending.
-	keys := make([]string, 0, len(store.cache))
-
-	for key, dbValue := range store.cache {
-		if dbValue.dirty {
-			keys = append(keys, key)
-		}
-	}
-
-	sort.Strings(keys)
-
+	// The sorted cache holds exactly the dirty items, in ascending order.
 	// TODO: Consider allowing usage of Batch, which would allow the write to
 	// at least happen atomically.
-	for _, key := range keys {
-		cacheValue := store.cache[key]
+	store.sortedCache.Ascend(func(i btree.Item) bool {
+		key := i.(item).key
+		cacheValue := store.cache[string(key)]
 
 		switch {
 		case cacheValue.deleted:
-			store.parent.Delete([]byte(key))
+			store.parent.Delete(key)
 		case cacheValue.value == nil:
 			// Skip, it already doesn't exist in parent.
 		default:
-			store.parent.Set([]byte(key), cacheValue.value)
+			store.parent.Set(key, cacheValue.value)
 		}
-	}
+
+		return true
+	})
 
 	// Clear the cache
 	store.cache = make(map[string]*cValue)
-	store.unsortedCache = make(map[string]struct{})
-	store.sortedCache = list.New()
+	store.sortedCache = btree.New(sortedCacheDegree)
 }
 
 //----------------------------------------
@@ -173,54 +161,13 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = store.parent.ReverseIterator(start, end)
 	}
 
-	store.dirtyItems(start, end)
-	cache = newMemIterator(start, end, store.sortedCache, ascending)
+	// Iterate over a copy-on-write snapshot of the dirty items, so that the
+	// store can be written to while the iterator is open.
+	cache = newMemIterator(start, end, store.sortedCache.Clone(), ascending)
 
 	return newCacheMergeIterator(parent, cache, ascending)
 }
 
-// Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
-	unsorted := make([]*tmkv.Pair, 0)
-
-	for key := range store.unsortedCache {
-		cacheValue := store.cache[key]
-
-		if dbm.IsKeyInDomain([]byte(key), start, end) {
-			unsorted = append(unsorted, &tmkv.Pair{Key: []byte(key), Value: cacheValue.value})
-
-			delete(store.unsortedCache, key)
-		}
-	}
-
-	sort.Slice(unsorted, func(i, j int) bool {
-		return bytes.Compare(unsorted[i].Key, unsorted[j].Key) < 0
-	})
-
-	for e := store.sortedCache.Front(); e != nil && len(unsorted) != 0; {
-		uitem := unsorted[0]
-		sitem := e.Value.(*tmkv.Pair)
-		comp := bytes.Compare(uitem.Key, sitem.Key)
-
-		switch comp {
-		case -1:
-			unsorted = unsorted[1:]
-
-			store.sortedCache.InsertBefore(uitem, e)
-		case 1:
-			e = e.Next()
-		case 0:
-			unsorted = unsorted[1:]
-			e.Value = uitem
-			e = e.Next()
-		}
-	}
-
-	for _, kvp := range unsorted {
-		store.sortedCache.PushBack(kvp)
-	}
-}
-
 //----------------------------------------
 // etc
 
@@ -232,6 +179,8 @@ func (store *Store) setCacheValue(key, value []byte, deleted bool, dirty bool) {
 		dirty:   dirty,
 	}
 	if dirty {
-		store.unsortedCache[string(key)] = struct{}{}
+		// copy the key, as the map does, so that the caller reusing its buffer
+		// does not corrupt the ordering of the dirty items
+		store.sortedCache.ReplaceOrInsert(item{key: append([]byte(nil), key...), value: value})
 	}
 }
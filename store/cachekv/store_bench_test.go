@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
 func benchmarkCacheKVStoreIterator(numKVs int, b *testing.B) {
@@ -44,3 +45,68 @@ func BenchmarkCacheKVStoreIterator1000(b *testing.B)   { benchmarkCacheKVStoreIt
 func BenchmarkCacheKVStoreIterator10000(b *testing.B)  { benchmarkCacheKVStoreIterator(10000, b) }
 func BenchmarkCacheKVStoreIterator50000(b *testing.B)  { benchmarkCacheKVStoreIterator(50000, b) }
 func BenchmarkCacheKVStoreIterator100000(b *testing.B) { benchmarkCacheKVStoreIterator(100000, b) }
+
+// benchmarkCacheKVStoreQueue simulates a queue processed by an EndBlocker: on
+// every step, a new dirty item is written to a store already holding numKVs
+// dirty items and the head of the queue is read through a new iterator.
+func benchmarkCacheKVStoreQueue(numKVs int, b *testing.B) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	cstore := cachekv.NewStore(mem)
+
+	for i := 0; i < numKVs; i++ {
+		key := make([]byte, 32)
+		value := make([]byte, 32)
+
+		_, _ = rand.Read(key)
+		_, _ = rand.Read(value)
+
+		cstore.Set(key, value)
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		cstore.Set(key, key)
+
+		iter := cstore.Iterator(nil, nil)
+		_ = iter.Key()
+		iter.Close()
+	}
+}
+
+func BenchmarkCacheKVStoreQueue500(b *testing.B)   { benchmarkCacheKVStoreQueue(500, b) }
+func BenchmarkCacheKVStoreQueue10000(b *testing.B) { benchmarkCacheKVStoreQueue(10000, b) }
+func BenchmarkCacheKVStoreQueue50000(b *testing.B) { benchmarkCacheKVStoreQueue(50000, b) }
+
+// benchmarkCacheKVStoreNested measures creating an iterator and reading its
+// first item through the given number of nested cache layers, each holding
+// numKVs dirty items.
+func benchmarkCacheKVStoreNested(numKVs, depth int, b *testing.B) {
+	var store types.CacheKVStore = cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+
+	for d := 0; d < depth; d++ {
+		for i := 0; i < numKVs; i++ {
+			key := make([]byte, 32)
+			_, _ = rand.Read(key)
+			store.Set(key, key)
+		}
+
+		store = cachekv.NewStore(store)
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		store.Set(key, key)
+
+		iter := store.Iterator(nil, nil)
+		_ = iter.Key()
+		iter.Close()
+	}
+}
+
+func BenchmarkCacheKVStoreNested10000Depth5(b *testing.B) { benchmarkCacheKVStoreNested(10000, 5, b) }
@@ -72,6 +72,27 @@ func TestCacheKVStoreNoNilSet(t *testing.T) {
 	require.Panics(t, func() { st.Set([]byte(""), []byte("value")) }, "setting an empty key should panic")
 }
 
+func TestCacheKVStoreReusedKeyBuffer(t *testing.T) {
+	st := newCacheKVStore()
+
+	// the store must not keep a reference to the caller's key
+	key := []byte("key00000000")
+	for i := 3; i > 0; i-- {
+		copy(key, keyFmt(i))
+		st.Set(key, valFmt(i))
+	}
+
+	itr := st.Iterator(nil, nil)
+	for i := 1; i <= 3; i++ {
+		require.True(t, itr.Valid())
+		require.Equal(t, keyFmt(i), itr.Key())
+		require.Equal(t, valFmt(i), itr.Value())
+		itr.Next()
+	}
+	require.False(t, itr.Valid())
+	itr.Close()
+}
+
 func TestCacheKVStoreNested(t *testing.T) {
 	mem := dbadapter.Store{DB: dbm.NewMemDB()}
 	st := cachekv.NewStore(mem)
@@ -152,6 +173,56 @@ func TestCacheKVIteratorBounds(t *testing.T) {
 	require.Equal(t, 4, i)
 }
 
+func TestCacheKVIteratorManyItems(t *testing.T) {
+	st := newCacheKVStore()
+
+	// set more items than are looked up at once by the iterator over the cache
+	nItems := 200
+	for i := 0; i < nItems; i++ {
+		st.Set(keyFmt(i), valFmt(i))
+	}
+
+	// iterate over a range, forwards and backwards
+	itr := st.Iterator(keyFmt(10), keyFmt(150))
+	i := 10
+	for ; itr.Valid(); itr.Next() {
+		require.Equal(t, keyFmt(i), itr.Key())
+		require.Equal(t, valFmt(i), itr.Value())
+		i++
+	}
+	require.Equal(t, 150, i)
+	itr.Close()
+
+	itr = st.ReverseIterator(keyFmt(10), keyFmt(150))
+	i = 149
+	for ; itr.Valid(); itr.Next() {
+		require.Equal(t, keyFmt(i), itr.Key())
+		require.Equal(t, valFmt(i), itr.Value())
+		i--
+	}
+	require.Equal(t, 9, i)
+	itr.Close()
+
+	// writes while iterating are not visible to the open iterator
+	itr = st.Iterator(nil, nil)
+	i = 0
+	for ; itr.Valid(); itr.Next() {
+		require.Equal(t, keyFmt(i), itr.Key())
+		st.Delete(keyFmt(i + 1))
+		st.Set(keyFmt(nItems+i), valFmt(nItems+i))
+		i++
+	}
+	require.Equal(t, nItems, i)
+	itr.Close()
+
+	// and visible to the next one
+	itr = st.Iterator(nil, nil)
+	require.Equal(t, keyFmt(0), itr.Key())
+	itr.Next()
+	require.Equal(t, keyFmt(nItems+1), itr.Key())
+	itr.Close()
+}
+
 func TestCacheKVMergeIteratorBasics(t *testing.T) {
 	st := newCacheKVStore()
 
@@ -25,6 +25,9 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	// the trace contexts of the stores, naming each of them
+	storeTraceContexts []types.TraceContext
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -37,16 +40,23 @@ func NewFromKVStore(
 	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
 ) Store {
 	cms := Store{
-		db:           cachekv.NewStore(store),
-		stores:       make(map[types.StoreKey]types.CacheWrap, len(stores)),
-		keys:         keys,
-		traceWriter:  traceWriter,
-		traceContext: traceContext,
+		db:          cachekv.NewStore(store),
+		stores:      make(map[types.StoreKey]types.CacheWrap, len(stores)),
+		keys:        keys,
+		traceWriter: traceWriter,
+	}
+
+	if cms.TracingEnabled() {
+		// copy the context, so that setting the one of this store does not
+		// affect the parent store
+		cms.traceContext = traceContext.Merge(nil)
 	}
 
 	for key, store := range stores {
 		if cms.TracingEnabled() {
-			cms.stores[key] = store.CacheWrapWithTrace(cms.traceWriter, cms.traceContext)
+			tc := cms.traceContext.Merge(types.TraceContext{types.TraceContextKeyStore: key.Name()})
+			cms.storeTraceContexts = append(cms.storeTraceContexts, tc)
+			cms.stores[key] = store.CacheWrapWithTrace(cms.traceWriter, tc)
 		} else {
 			cms.stores[key] = store.CacheWrap()
 		}
@@ -85,13 +95,20 @@ func (cms Store) SetTracer(w io.Writer) types.MultiStore {
 // the given context with the existing context by key. Any existing keys will
 // be overwritten. It is implied that the caller should update the context when
 // necessary between tracing operations. It returns a modified MultiStore.
+//
+// The context of the store is not shared with the store it was cache-wrapped
+// from, so that it does not affect the trace operations of the latter.
 func (cms Store) SetTracingContext(tc types.TraceContext) types.MultiStore {
-	if cms.traceContext != nil {
-		for k, v := range tc {
-			cms.traceContext[k] = v
+	if cms.traceContext == nil {
+		cms.traceContext = make(types.TraceContext, len(tc))
+	}
+
+	for k, v := range tc {
+		cms.traceContext[k] = v
+
+		for _, storeTC := range cms.storeTraceContexts {
+			storeTC[k] = v
 		}
-	} else {
-		cms.traceContext = tc
 	}
 
 	return cms
@@ -0,0 +1,148 @@
+package flatkv
+
+import (
+	"bytes"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.Iterator = (*versionIterator)(nil)
+
+// versionIterator iterates over the pairs of a store at an archived version.
+// The archive holds every version of each key next to each other, so that the
+// iterator walks all of them and yields, for each key, the value of its latest
+// version at or before the iterated one, skipping deleted keys.
+type versionIterator struct {
+	source     dbm.Iterator
+	prefixLen  int
+	version    int64
+	ascending  bool
+	start, end []byte
+
+	key, value []byte
+	valid      bool
+}
+
+func newVersionIterator(vs *versionStore, start, end []byte, ascending bool) *versionIterator {
+	dbStart, dbEnd := vs.prefix, types.PrefixEndBytes(vs.prefix)
+	if start != nil {
+		dbStart = append(append([]byte{}, vs.prefix...), escapeKey(start)...)
+	}
+	if end != nil {
+		dbEnd = append(append([]byte{}, vs.prefix...), escapeKey(end)...)
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+
+	if ascending {
+		source, err = vs.archive.db.Iterator(dbStart, dbEnd)
+	} else {
+		source, err = vs.archive.db.ReverseIterator(dbStart, dbEnd)
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	it := &versionIterator{
+		source:    source,
+		prefixLen: len(vs.prefix),
+		version:   vs.version,
+		ascending: ascending,
+		start:     start,
+		end:       end,
+	}
+	it.next()
+
+	return it
+}
+
+// next moves to the next key with a value at the iterated version.
+func (it *versionIterator) next() {
+	for it.source.Valid() {
+		// copy, as the source may reuse its buffers once moved
+		escaped := append([]byte{}, it.escapedKey(it.source.Key())...)
+
+		var value []byte
+
+		// walk all versions of the key, which are ascending when iterating
+		// forward and descending otherwise
+		for ; it.source.Valid() && bytes.Equal(it.escapedKey(it.source.Key()), escaped); it.source.Next() {
+			if it.sourceVersion() > it.version {
+				continue
+			}
+
+			if it.ascending || value == nil {
+				value = append([]byte{}, it.source.Value()...)
+			}
+		}
+
+		if value = decodeValue(value); value != nil {
+			it.key, it.value, it.valid = unescapeKey(escaped), value, true
+			return
+		}
+	}
+
+	it.key, it.value, it.valid = nil, nil, false
+}
+
+func (it *versionIterator) escapedKey(key []byte) []byte {
+	return key[it.prefixLen : len(key)-8]
+}
+
+func (it *versionIterator) sourceVersion() int64 {
+	key := it.source.Key()
+	return decodeVersion(key[len(key)-8:])
+}
+
+// Domain implements Iterator.
+func (it *versionIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements Iterator.
+func (it *versionIterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements Iterator.
+func (it *versionIterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	it.next()
+}
+
+// Key implements Iterator.
+func (it *versionIterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	return it.key
+}
+
+// Value implements Iterator.
+func (it *versionIterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	return it.value
+}
+
+// Error implements Iterator.
+func (it *versionIterator) Error() error {
+	return it.source.Error()
+}
+
+// Close implements Iterator.
+func (it *versionIterator) Close() {
+	it.source.Close()
+}
@@ -0,0 +1,451 @@
+/*
+Package flatkv implements a flat archive of the versioned key/value pairs of
+the stores of a multistore.
+
+IAVL trees answer reads at past versions by loading the tree of each version,
+which gets slow as trees grow. The archive instead records every write of each
+committed version next to the previous versions of the same key:
+
+	<prefix> | k | len(name) | name | escape(key) | version -> 0x01 | value, or 0x00 if deleted
+	<prefix> | m | first                                   -> first archived version
+	<prefix> | m | latest                                  -> latest archived version
+
+where keys are escaped so that the flat layout sorts by store, then key, then
+version. The value of a key at a version is the one of its latest write at or
+before that version, found by a single reverse seek. IAVL trees remain the
+source of proofs.
+*/
+package flatkv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	keysPrefix byte = 'k'
+	metaPrefix byte = 'm'
+
+	valueDeleted byte = 0x00
+	valueSet     byte = 0x01
+
+	// importBatchSize is the number of pairs written per batch by Import
+	importBatchSize = 10000
+)
+
+var (
+	firstVersionKey  = []byte("first")
+	latestVersionKey = []byte("latest")
+)
+
+// Store is a flat archive of the versioned key/value pairs of the stores of a
+// multistore, persisted under a prefix of its DB. Writes to the stores wrapped
+// with Wrap are recorded until they are flushed as a new version.
+type Store struct {
+	db     dbm.DB
+	prefix []byte
+
+	mtx     sync.Mutex
+	pending map[string]map[string][]byte // store name -> key -> value, nil if deleted
+}
+
+// NewStore returns an archive persisted in the given DB under the given prefix.
+func NewStore(db dbm.DB, prefix []byte) *Store {
+	return &Store{
+		db:      db,
+		prefix:  prefix,
+		pending: make(map[string]map[string][]byte),
+	}
+}
+
+// Versions returns the first and latest archived versions, which are zero if
+// no version was archived.
+func (s *Store) Versions() (first, latest int64) {
+	return s.getVersion(firstVersionKey), s.getVersion(latestVersionKey)
+}
+
+// HasVersion returns whether the given version is archived.
+func (s *Store) HasVersion(version int64) bool {
+	first, latest := s.Versions()
+	return first > 0 && first <= version && version <= latest
+}
+
+// Wrap returns a KVStore recording the writes to the given store of a
+// multistore into the archive, until they are flushed.
+func (s *Store) Wrap(name string, parent types.KVStore) types.KVStore {
+	return &recordingStore{parent: parent, archive: s, name: name}
+}
+
+// Flush writes the recorded writes to the given batch as the given version,
+// which becomes the latest archived version, and clears them.
+func (s *Store) Flush(batch dbm.Batch, version int64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for name, writes := range s.pending {
+		for key, value := range writes {
+			batch.Set(s.pairKey(name, []byte(key), version), encodeValue(value))
+		}
+	}
+
+	if first, _ := s.Versions(); first == 0 {
+		batch.Set(s.metaKey(firstVersionKey), encodeVersion(version))
+	}
+
+	batch.Set(s.metaKey(latestVersionKey), encodeVersion(version))
+	s.pending = make(map[string]map[string][]byte)
+}
+
+// Import archives all pairs of the given iterator as the state of the given
+// store at the given version. It is used to seed the archive with the state of
+// the version it starts at.
+func (s *Store) Import(name string, version int64, it types.Iterator) error {
+	defer it.Close()
+
+	batch := s.db.NewBatch()
+	count := 0
+
+	for ; it.Valid(); it.Next() {
+		batch.Set(s.pairKey(name, it.Key(), version), encodeValue(it.Value()))
+
+		if count++; count%importBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				batch.Close()
+				return err
+			}
+
+			batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+
+	defer batch.Close()
+
+	return batch.Write()
+}
+
+// SetVersions sets the first and latest archived versions.
+func (s *Store) SetVersions(first, latest int64) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	batch.Set(s.metaKey(firstVersionKey), encodeVersion(first))
+	batch.Set(s.metaKey(latestVersionKey), encodeVersion(latest))
+
+	return batch.WriteSync()
+}
+
+// Truncate deletes all archived versions newer than the given one, which
+// becomes the latest archived version. It walks the whole archive.
+func (s *Store) Truncate(version int64) error {
+	return s.deleteKeys(func(key []byte) bool {
+		return decodeVersion(key[len(key)-8:]) > version
+	}, version)
+}
+
+// Reset deletes all archived versions and recorded writes.
+func (s *Store) Reset() error {
+	s.mtx.Lock()
+	s.pending = make(map[string]map[string][]byte)
+	s.mtx.Unlock()
+
+	return s.deleteKeys(func([]byte) bool { return true }, 0)
+}
+
+// deleteKeys deletes the pairs matching del and sets the latest version.
+func (s *Store) deleteKeys(del func(key []byte) bool, latest int64) error {
+	start := append(append([]byte{}, s.prefix...), keysPrefix)
+
+	it, err := s.db.Iterator(start, types.PrefixEndBytes(start))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		if del(it.Key()) {
+			keys = append(keys, it.Key())
+		}
+	}
+
+	it.Close()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		batch.Delete(key)
+	}
+
+	if latest == 0 {
+		batch.Delete(s.metaKey(firstVersionKey))
+		batch.Delete(s.metaKey(latestVersionKey))
+	} else {
+		batch.Set(s.metaKey(latestVersionKey), encodeVersion(latest))
+	}
+
+	return batch.WriteSync()
+}
+
+// KVStore returns a read-only view of the given store at the given archived
+// version.
+func (s *Store) KVStore(name string, version int64) types.KVStore {
+	return &versionStore{archive: s, prefix: s.storePrefix(name), version: version}
+}
+
+func (s *Store) record(name string, key, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	writes, ok := s.pending[name]
+	if !ok {
+		writes = make(map[string][]byte)
+		s.pending[name] = writes
+	}
+
+	writes[string(key)] = value
+}
+
+func (s *Store) getVersion(key []byte) int64 {
+	bz, err := s.db.Get(s.metaKey(key))
+	if err != nil {
+		panic(err)
+	}
+
+	if bz == nil {
+		return 0
+	}
+
+	return decodeVersion(bz)
+}
+
+func (s *Store) metaKey(key []byte) []byte {
+	res := make([]byte, 0, len(s.prefix)+1+len(key))
+	res = append(res, s.prefix...)
+	res = append(res, metaPrefix)
+
+	return append(res, key...)
+}
+
+func (s *Store) storePrefix(name string) []byte {
+	if len(name) > 255 {
+		panic(fmt.Sprintf("store name too long: %s", name))
+	}
+
+	res := make([]byte, 0, len(s.prefix)+2+len(name))
+	res = append(res, s.prefix...)
+	res = append(res, keysPrefix, byte(len(name)))
+
+	return append(res, name...)
+}
+
+func (s *Store) pairKey(name string, key []byte, version int64) []byte {
+	res := append(s.storePrefix(name), escapeKey(key)...)
+	return append(res, encodeVersion(version)...)
+}
+
+// escapeKey escapes a key so that escaped keys sort like keys and no escaped
+// key is a prefix of another one: 0x00 bytes are escaped as 0x00 0xFF, and the
+// key is terminated by 0x00 0x00.
+func escapeKey(key []byte) []byte {
+	res := make([]byte, 0, len(key)+2)
+
+	for _, b := range key {
+		if b == 0x00 {
+			res = append(res, 0x00, 0xFF)
+		} else {
+			res = append(res, b)
+		}
+	}
+
+	return append(res, 0x00, 0x00)
+}
+
+// unescapeKey reverses escapeKey.
+func unescapeKey(bz []byte) []byte {
+	res := make([]byte, 0, len(bz))
+
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != 0x00 {
+			res = append(res, bz[i])
+			continue
+		}
+
+		if i+1 < len(bz) && bz[i+1] == 0xFF {
+			res = append(res, 0x00)
+			i++
+			continue
+		}
+
+		break
+	}
+
+	return res
+}
+
+func encodeValue(value []byte) []byte {
+	if value == nil {
+		return []byte{valueDeleted}
+	}
+
+	return append([]byte{valueSet}, value...)
+}
+
+// decodeValue returns the value of an archived pair, or nil if it is a
+// deletion.
+func decodeValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] == valueDeleted {
+		return nil
+	}
+
+	return bz[1:]
+}
+
+func encodeVersion(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+
+	return bz
+}
+
+func decodeVersion(bz []byte) int64 {
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+//----------------------------------------
+// recordingStore
+
+var _ types.KVStore = (*recordingStore)(nil)
+
+// recordingStore records the writes to a store into the archive.
+type recordingStore struct {
+	parent  types.KVStore
+	archive *Store
+	name    string
+}
+
+// GetStoreType implements Store.
+func (rs *recordingStore) GetStoreType() types.StoreType {
+	return rs.parent.GetStoreType()
+}
+
+// Get implements KVStore.
+func (rs *recordingStore) Get(key []byte) []byte {
+	return rs.parent.Get(key)
+}
+
+// Has implements KVStore.
+func (rs *recordingStore) Has(key []byte) bool {
+	return rs.parent.Has(key)
+}
+
+// Set implements KVStore.
+func (rs *recordingStore) Set(key, value []byte) {
+	rs.parent.Set(key, value)
+	rs.archive.record(rs.name, key, value)
+}
+
+// Delete implements KVStore.
+func (rs *recordingStore) Delete(key []byte) {
+	rs.parent.Delete(key)
+	rs.archive.record(rs.name, key, nil)
+}
+
+// Iterator implements KVStore.
+func (rs *recordingStore) Iterator(start, end []byte) types.Iterator {
+	return rs.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (rs *recordingStore) ReverseIterator(start, end []byte) types.Iterator {
+	return rs.parent.ReverseIterator(start, end)
+}
+
+// CacheWrap implements CacheWrapper.
+func (rs *recordingStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(rs)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (rs *recordingStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(rs, w, tc))
+}
+
+//----------------------------------------
+// versionStore
+
+var _ types.KVStore = (*versionStore)(nil)
+
+// versionStore is a read-only view of a store at an archived version.
+type versionStore struct {
+	archive *Store
+	prefix  []byte
+	version int64
+}
+
+// GetStoreType implements Store.
+func (vs *versionStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// Get implements KVStore.
+func (vs *versionStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	pairPrefix := append(append([]byte{}, vs.prefix...), escapeKey(key)...)
+
+	it, err := vs.archive.db.ReverseIterator(pairPrefix, append(pairPrefix, encodeVersion(vs.version+1)...))
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+
+	return decodeValue(it.Value())
+}
+
+// Has implements KVStore.
+func (vs *versionStore) Has(key []byte) bool {
+	return vs.Get(key) != nil
+}
+
+// Set implements KVStore.
+func (vs *versionStore) Set(_, _ []byte) {
+	panic("cannot write to an archived version")
+}
+
+// Delete implements KVStore.
+func (vs *versionStore) Delete(_ []byte) {
+	panic("cannot write to an archived version")
+}
+
+// Iterator implements KVStore.
+func (vs *versionStore) Iterator(start, end []byte) types.Iterator {
+	return newVersionIterator(vs, start, end, true)
+}
+
+// ReverseIterator implements KVStore.
+func (vs *versionStore) ReverseIterator(start, end []byte) types.Iterator {
+	return newVersionIterator(vs, start, end, false)
+}
+
+// CacheWrap implements CacheWrapper.
+func (vs *versionStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(vs)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (vs *versionStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(vs, w, tc))
+}
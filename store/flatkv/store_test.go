@@ -0,0 +1,134 @@
+package flatkv
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestEscapeKey(t *testing.T) {
+	keys := [][]byte{
+		{}, {0x00}, {0x00, 0x00}, {0x00, 0x01}, {0x00, 0xFF}, {0x01}, {0x01, 0x00}, {0xFF}, {0xFF, 0x00, 0xFF},
+	}
+
+	escaped := make([][]byte, len(keys))
+	for i, key := range keys {
+		escaped[i] = escapeKey(key)
+		require.Equal(t, key, unescapeKey(escaped[i]))
+	}
+
+	// escaped keys sort like keys, even followed by a version
+	require.True(t, sort.SliceIsSorted(escaped, func(i, j int) bool {
+		return bytes.Compare(append(escaped[i], 0xFF), escaped[j]) < 0
+	}))
+}
+
+func commit(t *testing.T, db dbm.DB, archive *Store, version int64) {
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	archive.Flush(batch, version)
+	require.NoError(t, batch.Write())
+}
+
+func readAll(it types.Iterator) (kvs []string) {
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		kvs = append(kvs, fmt.Sprintf("%s=%s", it.Key(), it.Value()))
+	}
+
+	return kvs
+}
+
+func TestArchiveVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	archive := NewStore(db, []byte("a/"))
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := archive.Wrap("store", parent)
+	other := archive.Wrap("other", dbadapter.Store{DB: dbm.NewMemDB()})
+
+	require.False(t, archive.HasVersion(1))
+
+	// version 1
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("1"))
+	store.Set([]byte("b\x00"), []byte("1"))
+	other.Set([]byte("a"), []byte("other"))
+	commit(t, db, archive, 1)
+
+	// version 2
+	store.Set([]byte("a"), []byte("2"))
+	store.Delete([]byte("b"))
+	store.Set([]byte("c"), []byte("2"))
+	commit(t, db, archive, 2)
+
+	// version 3 writes nothing, version 4 overwrites within the version
+	commit(t, db, archive, 3)
+	store.Set([]byte("b"), []byte("x"))
+	store.Set([]byte("b"), []byte("4"))
+	commit(t, db, archive, 4)
+
+	require.Equal(t, []byte("2"), parent.Get([]byte("a")))
+
+	first, latest := archive.Versions()
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4), latest)
+
+	v1, v2, v3, v4 := archive.KVStore("store", 1), archive.KVStore("store", 2), archive.KVStore("store", 3), archive.KVStore("store", 4)
+
+	require.Equal(t, []byte("1"), v1.Get([]byte("a")))
+	require.Equal(t, []byte("2"), v2.Get([]byte("a")))
+	require.Equal(t, []byte("2"), v3.Get([]byte("a")))
+	require.Equal(t, []byte("1"), v1.Get([]byte("b")))
+	require.Nil(t, v2.Get([]byte("b")))
+	require.False(t, v3.Has([]byte("b")))
+	require.Equal(t, []byte("4"), v4.Get([]byte("b")))
+	require.Nil(t, v1.Get([]byte("c")))
+	require.Nil(t, v4.Get([]byte("d")))
+	require.Equal(t, []byte("other"), archive.KVStore("other", 4).Get([]byte("a")))
+	require.Panics(t, func() { v1.Set([]byte("a"), []byte("1")) })
+
+	require.Equal(t, []string{"a=1", "b=1", "b\x00=1"}, readAll(v1.Iterator(nil, nil)))
+	require.Equal(t, []string{"a=2", "b\x00=1", "c=2"}, readAll(v3.Iterator(nil, nil)))
+	require.Equal(t, []string{"c=2", "b\x00=1", "b=4", "a=2"}, readAll(v4.ReverseIterator(nil, nil)))
+	require.Equal(t, []string{"b=4", "a=2"}, readAll(v4.ReverseIterator([]byte("a"), []byte("b\x00"))))
+	require.Equal(t, []string{"b=4", "b\x00=1"}, readAll(v4.Iterator([]byte("b"), []byte("c"))))
+	require.Equal(t, []string{"b\x00=1", "a=2"}, readAll(v2.ReverseIterator(nil, []byte("c"))))
+
+	// truncating deletes newer versions
+	require.NoError(t, archive.Truncate(2))
+	require.False(t, archive.HasVersion(3))
+	require.Nil(t, archive.KVStore("store", 4).Get([]byte("b")))
+	require.Equal(t, []byte("2"), archive.KVStore("store", 2).Get([]byte("a")))
+
+	// resetting deletes everything
+	require.NoError(t, archive.Reset())
+	require.False(t, archive.HasVersion(1))
+	require.Nil(t, archive.KVStore("store", 1).Get([]byte("a")))
+}
+
+func TestArchiveImport(t *testing.T) {
+	db := dbm.NewMemDB()
+	archive := NewStore(db, []byte("a/"))
+
+	source := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < 3*importBatchSize/2; i++ {
+		source.Set([]byte(fmt.Sprintf("key%06d", i)), []byte("value"))
+	}
+
+	require.NoError(t, archive.Import("store", 5, source.Iterator(nil, nil)))
+	require.NoError(t, archive.SetVersions(5, 5))
+	require.True(t, archive.HasVersion(5))
+	require.False(t, archive.HasVersion(4))
+
+	it := archive.KVStore("store", 5).Iterator(nil, nil)
+	require.Len(t, readAll(it), 3*importBatchSize/2)
+}
@@ -16,15 +16,23 @@ type Store struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
 	parent    types.KVStore
+	storeName string
 }
 
 // NewStore returns a reference to a new GasKVStore.
 // nolint
 func NewStore(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig) *Store {
+	return NewStoreWithName(parent, gasMeter, gasConfig, "")
+}
+
+// NewStoreWithName returns a reference to a new GasKVStore which reports the
+// gas it consumes under the given store name to a DetailedGasMeter.
+func NewStoreWithName(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig, storeName string) *Store {
 	kvs := &Store{
 		gasMeter:  gasMeter,
 		gasConfig: gasConfig,
 		parent:    parent,
+		storeName: storeName,
 	}
 	return kvs
 }
@@ -38,11 +46,11 @@ func (gs *Store) GetStoreType() types.StoreType {
 func (gs *Store) Get(key []byte) (value []byte) {
 	defer telemetry.MeasureSince(time.Now(), "store", "gaskv", "get")
 
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc, types.GasOperationRead)
 	value = gs.parent.Get(key)
 
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc, types.GasOperationRead)
 
 	return value
 }
@@ -53,16 +61,16 @@ func (gs *Store) Set(key []byte, value []byte) {
 
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc, types.GasOperationWrite)
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc, types.GasOperationWrite)
 	gs.parent.Set(key, value)
 }
 
 // Implements KVStore.
 func (gs *Store) Has(key []byte) bool {
 	defer telemetry.MeasureSince(time.Now(), "store", "gaskv", "has")
-	gs.gasMeter.ConsumeGas(gs.gasConfig.HasCost, types.GasHasDesc)
+	gs.consumeGas(gs.gasConfig.HasCost, types.GasHasDesc, types.GasOperationRead)
 	return gs.parent.Has(key)
 }
 
@@ -70,7 +78,7 @@ func (gs *Store) Has(key []byte) bool {
 func (gs *Store) Delete(key []byte) {
 	defer telemetry.MeasureSince(time.Now(), "store", "gaskv", "delete")
 	// charge gas to prevent certain attack vectors even though space is being freed
-	gs.gasMeter.ConsumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
+	gs.consumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc, types.GasOperationWrite)
 	gs.parent.Delete(key)
 }
 
@@ -107,7 +115,7 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = gs.parent.ReverseIterator(start, end)
 	}
 
-	gi := newGasIterator(gs.gasMeter, gs.gasConfig, parent)
+	gi := newGasIterator(gs.gasMeter, gs.gasConfig, gs.storeName, parent)
 	if gi.Valid() {
 		gi.(*gasIterator).consumeSeekGas()
 	}
@@ -115,16 +123,33 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	return gi
 }
 
+func (gs *Store) consumeGas(amount types.Gas, descriptor, operation string) {
+	consumeGas(gs.gasMeter, amount, descriptor, gs.storeName, operation)
+}
+
+// consumeGas consumes gas on the given meter, recording the store name and
+// operation kind if it is a DetailedGasMeter.
+func consumeGas(gasMeter types.GasMeter, amount types.Gas, descriptor, storeName, operation string) {
+	if dgm, ok := gasMeter.(types.DetailedGasMeter); ok {
+		dgm.ConsumeStoreGas(amount, descriptor, storeName, operation)
+		return
+	}
+
+	gasMeter.ConsumeGas(amount, descriptor)
+}
+
 type gasIterator struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
+	storeName string
 	parent    types.Iterator
 }
 
-func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, parent types.Iterator) types.Iterator {
+func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, storeName string, parent types.Iterator) types.Iterator {
 	return &gasIterator{
 		gasMeter:  gasMeter,
 		gasConfig: gasConfig,
+		storeName: storeName,
 		parent:    parent,
 	}
 }
@@ -179,6 +204,6 @@ func (gi *gasIterator) Error() error {
 func (gi *gasIterator) consumeSeekGas() {
 	value := gi.Value()
 
-	gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
-	gi.gasMeter.ConsumeGas(gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
+	consumeGas(gi.gasMeter, gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc, gi.storeName, types.GasOperationIterate)
+	consumeGas(gi.gasMeter, gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc, gi.storeName, types.GasOperationIterate)
 }
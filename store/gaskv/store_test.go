@@ -98,3 +98,31 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestGasKVStoreDetailedGasMeter(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewDetailedGasMeter(types.NewGasMeter(10000))
+	st := gaskv.NewStoreWithName(mem, meter, types.KVGasConfig(), "acc")
+
+	st.Set(keyFmt(1), valFmt(1))
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	require.True(t, st.Has(keyFmt(1)))
+
+	iterator := st.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+	}
+	iterator.Close()
+
+	st.Delete(keyFmt(1))
+	meter.ConsumeGas(10, "flat")
+
+	breakdown := meter.GasBreakdown()
+	require.Equal(t, []types.GasConsumption{{Name: "acc", Gas: 5567}}, breakdown.Stores)
+	require.Equal(t, []types.GasConsumption{
+		{Name: types.GasOperationFlat, Gas: 10},
+		{Name: types.GasOperationIterate, Gas: 138},
+		{Name: types.GasOperationRead, Gas: 2039},
+		{Name: types.GasOperationWrite, Gas: 3390},
+	}, breakdown.Operations)
+	require.Equal(t, meter.GasConsumed(), types.Gas(5577))
+}
@@ -62,6 +62,66 @@ func LoadStore(db dbm.DB, id types.CommitID, lazyLoading bool) (types.CommitKVSt
 	}, nil
 }
 
+// RollbackStore deletes all versions of the IAVL store persisted in the
+// provided DB that are newer than the given version, which becomes its latest
+// version. If the store has no such version, e.g. because it was added after
+// it, and all of its versions are newer, all of them are deleted.
+func RollbackStore(db dbm.DB, version int64) error {
+	tree, err := iavl.NewMutableTree(db, defaultIAVLCacheSize)
+	if err != nil {
+		return err
+	}
+
+	latest, err := tree.Load()
+	if err != nil {
+		return err
+	}
+
+	if latest <= version {
+		return nil
+	}
+
+	if !tree.VersionExists(version) {
+		for _, v := range tree.AvailableVersions() {
+			if int64(v) < version {
+				return fmt.Errorf("cannot roll back to missing version %d", version)
+			}
+		}
+
+		// loading version 0 for overwriting deletes all versions
+		version = 0
+	}
+
+	_, err = tree.LoadVersionForOverwriting(version)
+	return err
+}
+
+// StoreVersionExists returns whether the IAVL store persisted in the provided
+// DB has the given version, i.e. whether it was saved and not pruned.
+func StoreVersionExists(db dbm.DB, version int64) (bool, error) {
+	tree, err := iavl.NewMutableTree(db, defaultIAVLCacheSize)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := tree.Load(); err != nil {
+		return false, err
+	}
+
+	return tree.VersionExists(version), nil
+}
+
+// StoreLatestVersion returns the latest version of the IAVL store persisted in
+// the provided DB, which is zero if it has none.
+func StoreLatestVersion(db dbm.DB) (int64, error) {
+	tree, err := iavl.NewMutableTree(db, defaultIAVLCacheSize)
+	if err != nil {
+		return 0, err
+	}
+
+	return tree.Load()
+}
+
 // UnsafeNewStore returns a reference to a new IAVL Store with a given mutable
 // IAVL tree reference. It should only be used for testing purposes.
 //
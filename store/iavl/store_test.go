@@ -515,3 +515,24 @@ func BenchmarkIAVLIteratorNext(b *testing.B) {
 		}
 	}
 }
+
+func TestRollbackStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := LoadStore(db, types.CommitID{}, false)
+	require.NoError(t, err)
+
+	store.(types.KVStore).Set([]byte("key"), []byte("value"))
+	store.Commit()
+	store.Commit()
+
+	require.NoError(t, RollbackStore(db, 1))
+	store, err = LoadStore(db, types.CommitID{Version: 1}, false)
+	require.NoError(t, err)
+	require.False(t, store.(*Store).VersionExists(2))
+
+	// the store did not exist at version 0
+	require.NoError(t, RollbackStore(db, 0))
+	store, err = LoadStore(db, types.CommitID{}, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), store.LastCommitID().Version)
+}
@@ -3,7 +3,9 @@ package rootmulti
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"sync"
 
 	ics23 "github.com/confio/ics23/go"
 	"github.com/pkg/errors"
@@ -15,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/flatkv"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/mem"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/rootmulti/internal/maps"
@@ -28,6 +31,8 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	pruneHeightsKey  = "s/pruneheights"
+	pendingCommitKey = "s/pending"
+	archivePrefix    = "s/a/"
 	commitInfoKeyFmt = "s/%d" // s/<version>
 )
 
@@ -45,11 +50,14 @@ type Store struct {
 	keysByName     map[string]types.StoreKey
 	lazyLoading    bool
 	pruneHeights   []int64
+	commitWorkers  int
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+	archive         *flatkv.Store
+	archiveReset    bool
 }
 
 var (
@@ -84,6 +92,30 @@ func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetCommitWorkers sets the number of stores committed concurrently. A value
+// of one or less, the default, commits stores serially.
+func (rs *Store) SetCommitWorkers(workers int) {
+	rs.commitWorkers = workers
+}
+
+// SetArchiving sets whether the writes to the IAVL stores of each committed
+// version are archived in a flat layout, which serves historical reads faster
+// than loading past IAVL trees. It must be called before loading a version.
+func (rs *Store) SetArchiving(enabled bool) {
+	if enabled {
+		rs.archive = flatkv.NewStore(rs.db, []byte(archivePrefix))
+	} else {
+		rs.archive = nil
+	}
+}
+
+// SetArchiveReset sets whether an archive that cannot be brought in line with
+// the loaded version without discarding archived versions, e.g. because it
+// stopped archiving at an earlier version, is reset instead of failing the load.
+func (rs *Store) SetArchiveReset(reset bool) {
+	rs.archiveReset = reset
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -152,6 +184,27 @@ func (rs *Store) LoadVersion(ver int64) error {
 }
 
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	if err := rs.recoverPendingCommit(); err != nil {
+		return errors.Wrap(err, "failed to recover interrupted commit")
+	}
+
+	// if stores lost the latest versions, e.g. in a power loss, the multistore
+	// is rolled back to the latest version they all hold
+	if ver != 0 && ver == getLatestVersion(rs.db) {
+		synced, err := rs.syncedVersion(ver)
+		if err != nil {
+			return err
+		}
+
+		if synced < ver {
+			if err := rs.rollback(synced); err != nil {
+				return errors.Wrapf(err, "failed to roll back version %d lost by the stores", ver)
+			}
+
+			ver = synced
+		}
+	}
+
 	infos := make(map[string]storeInfo)
 	var cInfo commitInfo
 
@@ -182,7 +235,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 
 		// If it was deleted, remove all data
 		if upgrades.IsDeleted(key.Name()) {
-			if err := deleteKVStore(store.(types.KVStore)); err != nil {
+			if err := deleteKVStore(rs.archiveStore(key, store)); err != nil {
 				return errors.Wrapf(err, "failed to delete store %s", key.Name())
 			}
 		} else if oldName := upgrades.RenamedFrom(key.Name()); oldName != "" {
@@ -199,7 +252,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			}
 
 			// move all data
-			if err := moveKVStoreData(oldStore.(types.KVStore), store.(types.KVStore)); err != nil {
+			if err := moveKVStoreData(rs.archiveStore(oldKey, oldStore), rs.archiveStore(key, store)); err != nil {
 				return errors.Wrapf(err, "failed to move store %s -> %s", oldName, key.Name())
 			}
 		}
@@ -214,9 +267,253 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		rs.pruneHeights = ph
 	}
 
+	if rs.archive != nil {
+		if err := rs.syncArchive(ver); err != nil {
+			return errors.Wrap(err, "failed to sync archive")
+		}
+	}
+
 	return nil
 }
 
+// syncArchive brings the archive in line with the loaded version. Archived
+// versions are only discarded if the loaded version is the latest one of the
+// multistore, i.e. newer versions were rolled back, or if resetting the archive
+// was explicitly enabled; otherwise, e.g. when loading a past version, the load
+// fails. An archive that does not hold the loaded version, e.g. because
+// archiving was just enabled, is seeded with the state of the loaded version,
+// from which it then starts.
+func (rs *Store) syncArchive(ver int64) error {
+	first, latest := rs.archive.Versions()
+
+	switch {
+	case latest == ver:
+		return nil
+
+	case latest == 0:
+		// the archive is empty
+
+	case ver < latest && ver == getLatestVersion(rs.db):
+		if first <= ver {
+			return rs.archive.Truncate(ver)
+		}
+
+	case !rs.archiveReset:
+		return fmt.Errorf(
+			"archive holds versions %d to %d and cannot be synced to version %d without resetting it",
+			first, latest, ver,
+		)
+	}
+
+	if err := rs.archive.Reset(); err != nil {
+		return err
+	}
+
+	if ver == 0 {
+		return nil
+	}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		if err := rs.archive.Import(key.Name(), ver, store.Iterator(nil, nil)); err != nil {
+			return err
+		}
+	}
+
+	return rs.archive.SetVersions(ver, ver)
+}
+
+// archiveStore wraps a mounted IAVL store so that its writes are archived, if
+// archiving is enabled.
+func (rs *Store) archiveStore(key types.StoreKey, store types.CommitKVStore) types.KVStore {
+	if rs.archive == nil || store.GetStoreType() != types.StoreTypeIAVL {
+		return store
+	}
+
+	return rs.archive.Wrap(key.Name(), store)
+}
+
+// isArchived returns whether a past version is read from the archive.
+func (rs *Store) isArchived(version int64) bool {
+	return rs.archive != nil && version > 0 && version < rs.lastCommitInfo.Version && rs.archive.HasVersion(version)
+}
+
+// recoverPendingCommit rolls back the stores partially committed by an
+// interrupted Commit, as recorded by its write-ahead record, to the latest
+// version of the multistore. Committing a version first commits every store
+// and then persists its commitInfo, so that a crash in between leaves stores
+// holding a version the multistore does not know of.
+func (rs *Store) recoverPendingCommit() error {
+	pending, err := getPendingCommit(rs.db)
+	if err != nil || pending == 0 {
+		return err
+	}
+
+	latest := getLatestVersion(rs.db)
+	if pending > latest {
+		infos := make(map[string]storeInfo)
+		if latest > 0 {
+			cInfo, err := getCommitInfo(rs.db, latest)
+			if err != nil {
+				return err
+			}
+
+			for _, si := range cInfo.StoreInfos {
+				infos[si.Name] = si
+			}
+		}
+
+		// the versions of a store may be offset from the ones of the multistore,
+		// and a store it did not commit is emptied
+		for key, params := range rs.storesParams {
+			if params.typ != types.StoreTypeIAVL {
+				continue
+			}
+
+			version := rs.getCommitID(infos, key.Name()).Version
+			if err := iavl.RollbackStore(rs.storeDB(params), version); err != nil {
+				return errors.Wrapf(err, "failed to roll back store %s to version %d", key.Name(), version)
+			}
+		}
+	}
+
+	return rs.db.DeleteSync([]byte(pendingCommitKey))
+}
+
+// RollbackToVersion reverts the multistore and its mounted IAVL stores to the
+// given version, deleting all newer versions, and loads it. It must not be
+// called while the multistore is in use. The rollback is recorded with the
+// write-ahead record of Commit, so that an interrupted rollback is completed
+// when the multistore is next loaded.
+func (rs *Store) RollbackToVersion(target int64) error {
+	if err := rs.recoverPendingCommit(); err != nil {
+		return errors.Wrap(err, "failed to recover interrupted commit")
+	}
+
+	if err := rs.rollback(target); err != nil {
+		return err
+	}
+
+	if rs.interBlockCache != nil {
+		rs.interBlockCache.Reset()
+	}
+
+	rs.pruneHeights = make([]int64, 0)
+
+	return rs.loadVersion(target, nil)
+}
+
+// rollback deletes the versions of the multistore and of its mounted IAVL
+// stores newer than the given one, without loading it.
+func (rs *Store) rollback(target int64) error {
+	latest := getLatestVersion(rs.db)
+	if target <= 0 || target > latest {
+		return fmt.Errorf("cannot roll back to version %d; latest version is %d", target, latest)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, target)
+	if err != nil {
+		return err
+	}
+
+	infos := make(map[string]storeInfo)
+	for _, si := range cInfo.StoreInfos {
+		infos[si.Name] = si
+	}
+
+	// every IAVL store committed at the target version must still have its
+	// version committed then
+	for key, params := range rs.storesParams {
+		version := rs.getCommitID(infos, key.Name()).Version
+		if params.typ != types.StoreTypeIAVL || version == 0 {
+			continue
+		}
+
+		ok, err := iavl.StoreVersionExists(rs.storeDB(params), version)
+		if err != nil {
+			return err
+		} else if !ok {
+			return fmt.Errorf("cannot roll back store %s to pruned version %d", key.Name(), version)
+		}
+	}
+
+	if target < latest {
+		setPendingCommit(rs.db, latest)
+
+		batch := rs.db.NewBatch()
+		defer batch.Close()
+
+		for ver := target + 1; ver <= latest; ver++ {
+			batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, ver)))
+		}
+
+		setLatestVersion(batch, target)
+
+		if pruneHeights, err := getPruningHeights(rs.db); err == nil {
+			kept := pruneHeights[:0]
+			for _, h := range pruneHeights {
+				if h <= target {
+					kept = append(kept, h)
+				}
+			}
+
+			setPruningHeights(batch, kept)
+		}
+
+		if err := batch.WriteSync(); err != nil {
+			return errors.Wrap(err, "failed to write rollback metadata")
+		}
+
+		// the stores now hold versions newer than the latest one, which
+		// recovering from the pending commit rolls back
+		if err := rs.recoverPendingCommit(); err != nil {
+			return errors.Wrapf(err, "failed to roll back stores to version %d", target)
+		}
+	}
+
+	return nil
+}
+
+// syncedVersion returns the latest version of the multistore held by all of its
+// mounted IAVL stores. Unlike the commitInfo persisted after them, the writes of
+// the stores are not synced to disk, so that after a power loss a store may lag
+// behind the latest version of the multistore.
+func (rs *Store) syncedVersion(latest int64) (int64, error) {
+	cInfo, err := getCommitInfo(rs.db, latest)
+	if err != nil {
+		return 0, err
+	}
+
+	infos := make(map[string]storeInfo)
+	for _, si := range cInfo.StoreInfos {
+		infos[si.Name] = si
+	}
+
+	// each commit adds a version to every store, whose versions may however be
+	// offset from the ones of the multistore, e.g. if it was added by an upgrade
+	synced := latest
+	for key, params := range rs.storesParams {
+		committed := rs.getCommitID(infos, key.Name()).Version
+		if params.typ != types.StoreTypeIAVL || committed == 0 {
+			continue
+		}
+
+		ver, err := iavl.StoreLatestVersion(rs.storeDB(params))
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to get the latest version of store %s", key.Name())
+		}
+
+		if lost := committed - ver; lost > 0 && latest-lost < synced {
+			synced = latest - lost
+		}
+	}
+
+	return synced, nil
+}
+
 func (rs *Store) getCommitID(infos map[string]storeInfo, name string) types.CommitID {
 	info, ok := infos[name]
 	if !ok {
@@ -302,7 +599,12 @@ func (rs *Store) LastCommitID() types.CommitID {
 func (rs *Store) Commit() types.CommitID {
 	previousHeight := rs.lastCommitInfo.Version
 	version := previousHeight + 1
-	rs.lastCommitInfo = commitStores(version, rs.stores)
+
+	// Record the commit before writing to any store, so that a commit
+	// interrupted before its metadata is flushed can be rolled back on load.
+	setPendingCommit(rs.db, version)
+
+	rs.lastCommitInfo = commitStores(version, rs.stores, rs.commitWorkers)
 
 	// Determine if pruneHeight height needs to be added to the list of heights to
 	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
@@ -323,7 +625,7 @@ func (rs *Store) Commit() types.CommitID {
 		rs.pruneStores()
 	}
 
-	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
+	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights, rs.archive)
 
 	return types.CommitID{
 		Version: version,
@@ -373,7 +675,7 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		stores[k] = rs.archiveStore(k, v)
 	}
 
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
@@ -382,12 +684,20 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// iterating at past heights. Past versions held by the archive, if archiving
+// is enabled, are read from it.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	archived := rs.isArchived(version)
+
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
 		switch store.GetStoreType() {
 		case types.StoreTypeIAVL:
+			if archived {
+				cachedStores[key] = rs.archive.KVStore(key.Name(), version)
+				continue
+			}
+
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
@@ -431,10 +741,11 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
-	store := rs.stores[key].(types.KVStore)
+	store := rs.archiveStore(key, rs.stores[key])
 
 	if rs.TracingEnabled() {
-		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
+		tc := rs.traceContext.Merge(types.TraceContext{types.TraceContextKeyStore: key.Name()})
+		store = tracekv.NewStore(store, rs.traceWriter, tc)
 	}
 
 	return store
@@ -471,6 +782,11 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such store: %s", storeName))
 	}
 
+	// serve past versions without proofs from the archive
+	if !req.Prove && store.GetStoreType() == types.StoreTypeIAVL && rs.isArchived(req.Height) {
+		return queryArchive(rs.archive.KVStore(storeName, req.Height), subpath, req)
+	}
+
 	queryable, ok := store.(types.Queryable)
 	if !ok {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store))
@@ -508,6 +824,40 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	return res
 }
 
+// queryArchive answers a query without proof on a store at an archived
+// version, as the IAVL store would.
+func queryArchive(store types.KVStore, path string, req abci.RequestQuery) (res abci.ResponseQuery) {
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+
+	res.Height = req.Height
+
+	switch path {
+	case "/key":
+		res.Key = req.Data
+		res.Value = store.Get(req.Data)
+
+	case "/subspace":
+		var kvs []types.KVPair
+
+		res.Key = req.Data
+
+		iterator := types.KVStorePrefixIterator(store, req.Data)
+		for ; iterator.Valid(); iterator.Next() {
+			kvs = append(kvs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+
+		iterator.Close()
+		res.Value = cdc.MustMarshalBinaryBare(kvs)
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", path))
+	}
+
+	return res
+}
+
 // parsePath expects a format like /<storeName>[/<subpath>]
 // Must start with /, subpath may be empty
 // Returns error if it doesn't start with /
@@ -526,16 +876,25 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 	return storeName, subpath, nil
 }
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
+// storeDB returns the DB persisting the store with the given params.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
+	return storeDB(rs.db, params.db, params.key.Name())
+}
 
-	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+// storeDB returns the database of the store of the given name, prefixed in its
+// own database if it has one, or in the database of the multistore otherwise.
+func storeDB(db, ownDB dbm.DB, name string) dbm.DB {
+	if ownDB != nil {
+		return dbm.NewPrefixDB(ownDB, []byte("s/_/"))
 	}
 
+	prefix := "s/k:" + name + "/"
+	return dbm.NewPrefixDB(db, []byte(prefix))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.storeDB(params)
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -677,6 +1036,49 @@ func (si storeInfo) GetHash() []byte {
 //----------------------------------------
 // Misc.
 
+// GetLatestVersion returns the latest version committed to a multistore DB
+// without loading its stores, or zero if none was committed.
+func GetLatestVersion(db dbm.DB) int64 {
+	return getLatestVersion(db)
+}
+
+// GetStoreHashes returns the root hash of every store committed to a multistore
+// DB at the given version, keyed by store name, without loading the stores.
+func GetStoreHashes(db dbm.DB, version int64) (map[string][]byte, error) {
+	cInfo, err := getCommitInfo(db, version)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[string][]byte, len(cInfo.StoreInfos))
+	for _, si := range cInfo.StoreInfos {
+		hashes[si.Name] = si.GetHash()
+	}
+
+	return hashes, nil
+}
+
+// LoadIAVLStoreVersion returns the IAVL store of the given name as committed to a
+// multistore DB at the given version, read from ownDB if the store is held in
+// its own database. Only that version is loaded, and unlike loading the
+// multistore nothing is written to the databases, e.g. to recover from an
+// interrupted commit, so that the state of a stopped node can be inspected
+// without being modified.
+func LoadIAVLStoreVersion(db, ownDB dbm.DB, name string, version int64) (types.KVStore, error) {
+	return iavl.LoadStore(storeDB(db, ownDB, name), types.CommitID{Version: version}, true)
+}
+
+// GetCommitID returns the commit ID of a multistore DB at the given version, as
+// recorded when it was committed, without loading or modifying the multistore.
+func GetCommitID(db dbm.DB, version int64) (types.CommitID, error) {
+	cInfo, err := getCommitInfo(db, version)
+	if err != nil {
+		return types.CommitID{}, err
+	}
+
+	return cInfo.CommitID(), nil
+}
+
 func getLatestVersion(db dbm.DB) int64 {
 	var latest int64
 	latestBytes, err := db.Get([]byte(latestVersionKey))
@@ -694,20 +1096,78 @@ func getLatestVersion(db dbm.DB) int64 {
 	return latest
 }
 
-// Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore) commitInfo {
-	storeInfos := make([]storeInfo, 0, len(storeMap))
+// Commits each store, using up to the given number of concurrent workers, and
+// returns a new commitInfo. The stores are independent of each other, and the
+// store infos are sorted by name so that the commitInfo does not depend on the
+// order in which stores complete.
+func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, workers int) commitInfo {
+	keys := make([]types.StoreKey, 0, len(storeMap))
+	for key := range storeMap {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	commitIDs := make([]types.CommitID, len(keys))
+
+	if workers <= 1 || len(keys) <= 1 {
+		for i, key := range keys {
+			commitIDs[i] = storeMap[key].Commit()
+		}
+	} else {
+		if workers > len(keys) {
+			workers = len(keys)
+		}
+
+		var (
+			wg     sync.WaitGroup
+			panics = make(chan interface{}, len(keys))
+			jobs   = make(chan int, len(keys))
+		)
+
+		for i := range keys {
+			jobs <- i
+		}
+		close(jobs)
+
+		wg.Add(workers)
+		for w := 0; w < workers; w++ {
+			go func() {
+				defer wg.Done()
+
+				for i := range jobs {
+					func() {
+						// propagate store panics to the caller, as in the serial path
+						defer func() {
+							if r := recover(); r != nil {
+								panics <- r
+							}
+						}()
+
+						commitIDs[i] = storeMap[keys[i]].Commit()
+					}()
+				}
+			}()
+		}
 
-	for key, store := range storeMap {
-		commitID := store.Commit()
+		wg.Wait()
+		close(panics)
 
-		if store.GetStoreType() == types.StoreTypeTransient {
+		if r, ok := <-panics; ok {
+			panic(r)
+		}
+	}
+
+	storeInfos := make([]storeInfo, 0, len(keys))
+
+	for i, key := range keys {
+		if storeMap[key].GetStoreType() == types.StoreTypeTransient {
 			continue
 		}
 
 		si := storeInfo{}
 		si.Name = key.Name()
-		si.Core.CommitID = commitID
+		si.Core.CommitID = commitIDs[i]
 		storeInfos = append(storeInfos, si)
 	}
 
@@ -771,15 +1231,50 @@ func getPruningHeights(db dbm.DB) ([]int64, error) {
 	return prunedHeights, nil
 }
 
-func flushMetadata(db dbm.DB, version int64, cInfo commitInfo, pruneHeights []int64) {
+// getPendingCommit returns the version of the commit recorded as in progress,
+// or zero if there is none.
+func getPendingCommit(db dbm.DB) (int64, error) {
+	bz, err := db.Get([]byte(pendingCommitKey))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending commit: %w", err)
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+
+	var version int64
+	if err := cdc.UnmarshalBinaryBare(bz, &version); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal pending commit: %w", err)
+	}
+
+	return version, nil
+}
+
+func setPendingCommit(db dbm.DB, version int64) {
+	bz := cdc.MustMarshalBinaryBare(version)
+	if err := db.SetSync([]byte(pendingCommitKey), bz); err != nil {
+		panic(fmt.Errorf("error on setting pending commit %w", err))
+	}
+}
+
+// flushMetadata persists the commitInfo of a version, along with its archived
+// writes if archiving is enabled, and completes its commit by deleting its
+// write-ahead record in the same batch. The batch is synced so that the
+// version is durable once Commit returns.
+func flushMetadata(db dbm.DB, version int64, cInfo commitInfo, pruneHeights []int64, archive *flatkv.Store) {
 	batch := db.NewBatch()
 	defer batch.Close()
 
+	if archive != nil {
+		archive.Flush(batch, version)
+	}
+
 	setCommitInfo(batch, version, cInfo)
 	setLatestVersion(batch, version)
 	setPruningHeights(batch, pruneHeights)
+	batch.Delete([]byte(pendingCommitKey))
 
-	if err := batch.Write(); err != nil {
+	if err := batch.WriteSync(); err != nil {
 		panic(fmt.Errorf("error on batch write %w", err))
 	}
 }
@@ -0,0 +1,52 @@
+package rootmulti
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// benchmarkMultistoreCommit measures committing a block writing numKVs items
+// to each of numStores IAVL stores, using the given number of commit workers.
+func benchmarkMultistoreCommit(b *testing.B, numStores, numKVs, workers int) {
+	store := NewStore(dbm.NewMemDB())
+	store.SetCommitWorkers(workers)
+
+	keys := make([]types.StoreKey, numStores)
+	for i := range keys {
+		keys[i] = types.NewKVStoreKey(fmt.Sprintf("store%d", i))
+		store.MountStoreWithDB(keys[i], types.StoreTypeIAVL, nil)
+	}
+
+	if err := store.LoadLatestVersion(); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+
+		for _, key := range keys {
+			kv := store.GetKVStore(key)
+
+			for i := 0; i < numKVs; i++ {
+				bz := make([]byte, 32)
+				_, _ = rand.Read(bz)
+				kv.Set(bz, bz)
+			}
+		}
+
+		b.StartTimer()
+
+		store.Commit()
+	}
+}
+
+func BenchmarkMultistoreCommitSerial(b *testing.B)   { benchmarkMultistoreCommit(b, 20, 500, 1) }
+func BenchmarkMultistoreCommit4Workers(b *testing.B) { benchmarkMultistoreCommit(b, 20, 500, 4) }
+func BenchmarkMultistoreCommit8Workers(b *testing.B) { benchmarkMultistoreCommit(b, 20, 500, 8) }
@@ -174,6 +174,232 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestMultistoreCommitRecovery(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	k, v1, v2 := []byte("key"), []byte("value1"), []byte("value2")
+
+	store.getStoreByName("store1").(types.KVStore).Set(k, v1)
+	commitID := store.Commit()
+	require.Equal(t, int64(1), commitID.Version)
+
+	// simulate a commit of version 2 interrupted after committing store1 only
+	setPendingCommit(db, 2)
+	s1 := store.getStoreByName("store1").(*iavl.Store)
+	s1.Set(k, v2)
+	s1.Commit()
+	require.True(t, s1.VersionExists(2))
+
+	// loading rolls back store1 to the latest version of the multistore
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	checkStore(t, store, commitID, store.LastCommitID())
+
+	s1 = store.getStoreByName("store1").(*iavl.Store)
+	require.False(t, s1.VersionExists(2))
+	require.Equal(t, v1, s1.Get(k))
+
+	pending, err := getPendingCommit(db)
+	require.NoError(t, err)
+	require.Zero(t, pending)
+
+	// version 2 can be committed again with different data
+	s1.Set(k, []byte("value3"))
+	commitID = store.Commit()
+	require.Equal(t, getExpectedCommitID(store, 2), commitID)
+
+	pending, err = getPendingCommit(db)
+	require.NoError(t, err)
+	require.Zero(t, pending)
+
+	// a write-ahead record of a completed commit is ignored
+	setPendingCommit(db, 2)
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	checkStore(t, store, commitID, store.LastCommitID())
+	require.Equal(t, []byte("value3"), store.getStoreByName("store1").(types.KVStore).Get(k))
+}
+
+func TestMultistoreRecoverLostStoreVersions(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	k := []byte("key")
+	commitIDs := make([]types.CommitID, 3)
+
+	for i := range commitIDs {
+		store.getStoreByName("store1").(types.KVStore).Set(k, []byte(fmt.Sprintf("value%d", i+1)))
+		store.getStoreByName("store2").(types.KVStore).Set(k, []byte(fmt.Sprintf("value%d", i+1)))
+		commitIDs[i] = store.Commit()
+	}
+
+	// simulate the unsynced writes of version 3 of store2 lost in a power loss
+	store2DB := dbm.NewPrefixDB(db, []byte("s/k:store2/"))
+	require.NoError(t, iavl.RollbackStore(store2DB, 2))
+
+	// loading rolls back the multistore to the latest version of every store
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	checkStore(t, store, commitIDs[1], store.LastCommitID())
+	require.False(t, store.getStoreByName("store1").(*iavl.Store).VersionExists(3))
+	require.Equal(t, []byte("value2"), store.getStoreByName("store1").(types.KVStore).Get(k))
+
+	_, err := getCommitInfo(db, 3)
+	require.Error(t, err)
+
+	// the multistore cannot be recovered if the other stores pruned the version
+	require.NoError(t, store.getStoreByName("store1").(*iavl.Store).DeleteVersions(1))
+	require.NoError(t, iavl.RollbackStore(store2DB, 1))
+
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.Error(t, store.LoadLatestVersion())
+}
+
+func TestMultistoreRollback(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	k := []byte("key")
+	commitIDs := make([]types.CommitID, 4)
+
+	for i := range commitIDs {
+		store.getStoreByName("store1").(types.KVStore).Set(k, []byte(fmt.Sprintf("value%d", i+1)))
+		commitIDs[i] = store.Commit()
+	}
+
+	require.Error(t, store.RollbackToVersion(0))
+	require.Error(t, store.RollbackToVersion(5))
+
+	// rolling back deletes every newer version and loads the target one
+	require.NoError(t, store.RollbackToVersion(2))
+	checkStore(t, store, commitIDs[1], store.LastCommitID())
+	require.Equal(t, []byte("value2"), store.getStoreByName("store1").(types.KVStore).Get(k))
+
+	s1 := store.getStoreByName("store1").(*iavl.Store)
+	require.True(t, s1.VersionExists(2))
+	require.False(t, s1.VersionExists(3))
+
+	_, err := getCommitInfo(db, 3)
+	require.Error(t, err)
+
+	// the rolled back state is durable and can be committed on
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	checkStore(t, store, commitIDs[1], store.LastCommitID())
+
+	store.getStoreByName("store1").(types.KVStore).Set(k, []byte("value3"))
+	require.Equal(t, commitIDs[2], store.Commit())
+
+	// a pruned version cannot be rolled back to
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	require.NoError(t, store.getStoreByName("store1").(*iavl.Store).DeleteVersions(1))
+	require.Error(t, store.RollbackToVersion(1))
+	checkStore(t, store, commitIDs[2], store.LastCommitID())
+}
+
+func TestMultistoreArchive(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	store.SetArchiving(true)
+	require.NoError(t, store.LoadLatestVersion())
+
+	key1 := store.keysByName["store1"]
+	k := []byte("key")
+
+	for i := 1; i <= 4; i++ {
+		s1 := store.GetKVStore(key1)
+		s1.Set(k, []byte(fmt.Sprintf("value%d", i)))
+		s1.Set([]byte(fmt.Sprintf("key%d", i)), []byte("x"))
+		if i == 3 {
+			s1.Delete([]byte("key1"))
+		}
+		store.Commit()
+	}
+
+	// past versions are read from the archive and match the IAVL state
+	require.True(t, store.isArchived(2))
+	require.False(t, store.isArchived(4))
+
+	for ver := int64(1); ver <= 4; ver++ {
+		cms, err := store.CacheMultiStoreWithVersion(ver)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", ver)), cms.GetKVStore(key1).Get(k))
+
+		res := store.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: ver})
+		require.EqualValues(t, 0, res.Code)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", ver)), res.Value)
+	}
+
+	cms, err := store.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	require.False(t, cms.GetKVStore(key1).Has([]byte("key1")))
+	require.True(t, cms.GetKVStore(key1).Has([]byte("key3")))
+	require.False(t, cms.GetKVStore(key1).Has([]byte("key4")))
+
+	// proofs are still served by the IAVL stores
+	res := store.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: 2, Prove: true})
+	require.EqualValues(t, 0, res.Code)
+	require.Equal(t, []byte("value2"), res.Value)
+	require.NotNil(t, res.Proof)
+
+	// loading a past version does not discard the newer archived versions
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	store.SetArchiving(true)
+	require.Error(t, store.LoadVersion(3))
+	first, latest := store.archive.Versions()
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4), latest)
+	require.NoError(t, store.LoadLatestVersion())
+
+	// rolling back truncates the archive
+	require.NoError(t, store.RollbackToVersion(2))
+	first, latest = store.archive.Versions()
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(2), latest)
+
+	// an archive lagging behind is only reset if explicitly enabled
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	store.Commit()
+
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	store.SetArchiving(true)
+	require.Error(t, store.LoadLatestVersion())
+
+	store.SetArchiveReset(true)
+	require.NoError(t, store.LoadLatestVersion())
+	first, latest = store.archive.Versions()
+	require.Equal(t, int64(3), first)
+	require.Equal(t, int64(3), latest)
+
+	// enabling archiving on an existing state seeds the archive from it
+	db = dbm.NewMemDB()
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	store.getStoreByName("store1").(types.KVStore).Set(k, []byte("value1"))
+	store.Commit()
+
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	store.SetArchiving(true)
+	require.NoError(t, store.LoadLatestVersion())
+	require.False(t, store.archive.HasVersion(0))
+	require.True(t, store.archive.HasVersion(1))
+
+	key1 = store.keysByName["store1"]
+	store.GetKVStore(key1).Set(k, []byte("value2"))
+	store.Commit()
+	require.True(t, store.isArchived(1))
+
+	cms, err = store.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), cms.GetKVStore(key1).Get(k))
+}
+
 func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -596,3 +822,34 @@ func hashStores(stores map[types.StoreKey]types.CommitKVStore) []byte {
 	}
 	return sdkmaps.SimpleHashFromMap(m)
 }
+
+func TestMultistoreParallelCommit(t *testing.T) {
+	newStore := func(workers int) *Store {
+		store := NewStore(dbm.NewMemDB())
+		for i := 0; i < 10; i++ {
+			store.MountStoreWithDB(types.NewKVStoreKey(fmt.Sprintf("store%d", i)), types.StoreTypeIAVL, nil)
+		}
+		store.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+		store.SetCommitWorkers(workers)
+		require.NoError(t, store.LoadLatestVersion())
+
+		return store
+	}
+
+	serial, parallel := newStore(1), newStore(4)
+
+	for version := int64(1); version <= 3; version++ {
+		for i := 0; i < 10; i++ {
+			for _, store := range []*Store{serial, parallel} {
+				kv := store.getStoreByName(fmt.Sprintf("store%d", i)).(types.KVStore)
+				kv.Set([]byte(fmt.Sprintf("key%d", version)), []byte(fmt.Sprintf("value%d", i)))
+			}
+		}
+
+		commitID := parallel.Commit()
+		require.Equal(t, serial.Commit(), commitID)
+		require.Equal(t, getExpectedCommitID(parallel, version).Version, commitID.Version)
+		require.Equal(t, serial.lastCommitInfo, parallel.lastCommitInfo)
+		require.Len(t, parallel.lastCommitInfo.StoreInfos, 10)
+	}
+}
@@ -15,3 +15,7 @@ func NewCommitMultiStore(db dbm.DB) types.CommitMultiStore {
 func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
 	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 }
+
+func NewCommitKVStoreCacheManagerWithLimits(limits cache.Limits, storeLimits map[string]cache.Limits) types.MultiStorePersistentCache {
+	return cache.NewCommitKVStoreCacheManagerWithLimits(limits, storeLimits)
+}
@@ -5,16 +5,18 @@ import (
 	"encoding/json"
 	"io"
 
+	"github.com/spf13/cast"
+
 	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
-	writeOp     operation = "write"
-	readOp      operation = "read"
-	deleteOp    operation = "delete"
-	iterKeyOp   operation = "iterKey"
-	iterValueOp operation = "iterValue"
+	WriteOp     Operation = "write"
+	ReadOp      Operation = "read"
+	DeleteOp    Operation = "delete"
+	IterKeyOp   Operation = "iterKey"
+	IterValueOp Operation = "iterValue"
 )
 
 type (
@@ -25,27 +27,45 @@ type (
 	// TODO: Should we use a buffered writer and implement Commit on
 	// Store?
 	Store struct {
-		parent  types.KVStore
-		writer  io.Writer
-		context types.TraceContext
+		parent     types.KVStore
+		writer     io.Writer
+		context    types.TraceContext
+		persistent bool
 	}
 
-	// operation represents an IO operation
-	operation string
+	// Operation represents an IO operation
+	Operation string
 
-	// traceOperation implements a traced KVStore operation
-	traceOperation struct {
-		Operation operation              `json:"operation"`
-		Key       string                 `json:"key"`
-		Value     string                 `json:"value"`
-		Metadata  map[string]interface{} `json:"metadata"`
+	// TraceOperation implements a traced KVStore operation, written as a line
+	// of JSON. Keys and values are base64 encoded. The well-known keys of the
+	// trace context are reported in their own fields, and the other ones as
+	// metadata.
+	//
+	// Operations on a committed store, as opposed to a cache or a transient
+	// store, i.e. the writes applied to the committed state and the reads
+	// reaching it, are marked as persistent.
+	// Those are the ones to replay in order to reproduce the state.
+	TraceOperation struct {
+		Operation   Operation              `json:"operation"`
+		Store       string                 `json:"store,omitempty"`
+		Key         string                 `json:"key"`
+		Value       string                 `json:"value"`
+		BlockHeight int64                  `json:"block_height,omitempty"`
+		TxHash      string                 `json:"tx_hash,omitempty"`
+		MsgIndex    *uint32                `json:"msg_index,omitempty"`
+		Persistent  bool                   `json:"persistent,omitempty"`
+		Metadata    map[string]interface{} `json:"metadata,omitempty"`
 	}
 )
 
 // NewStore returns a reference to a new traceKVStore given a parent
 // KVStore implementation and a buffered writer.
 func NewStore(parent types.KVStore, writer io.Writer, tc types.TraceContext) *Store {
-	return &Store{parent: parent, writer: writer, context: tc}
+	_, isCache := parent.(types.CacheKVStore)
+	persistent := !isCache && parent.GetStoreType() != types.StoreTypeTransient &&
+		parent.GetStoreType() != types.StoreTypeMemory
+
+	return &Store{parent: parent, writer: writer, context: tc, persistent: persistent}
 }
 
 // Get implements the KVStore interface. It traces a read operation and
@@ -53,7 +73,7 @@ func NewStore(parent types.KVStore, writer io.Writer, tc types.TraceContext) *St
 func (tkv *Store) Get(key []byte) []byte {
 	value := tkv.parent.Get(key)
 
-	writeOperation(tkv.writer, readOp, tkv.context, key, value)
+	tkv.writeOperation(ReadOp, key, value)
 	return value
 }
 
@@ -61,14 +81,14 @@ func (tkv *Store) Get(key []byte) []byte {
 // delegates the Set call to the parent KVStore.
 func (tkv *Store) Set(key []byte, value []byte) {
 	types.AssertValidKey(key)
-	writeOperation(tkv.writer, writeOp, tkv.context, key, value)
+	tkv.writeOperation(WriteOp, key, value)
 	tkv.parent.Set(key, value)
 }
 
 // Delete implements the KVStore interface. It traces a write operation and
 // delegates the Delete call to the parent KVStore.
 func (tkv *Store) Delete(key []byte) {
-	writeOperation(tkv.writer, deleteOp, tkv.context, key, nil)
+	tkv.writeOperation(DeleteOp, key, nil)
 	tkv.parent.Delete(key)
 }
 
@@ -101,17 +121,12 @@ func (tkv *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = tkv.parent.ReverseIterator(start, end)
 	}
 
-	return newTraceIterator(tkv.writer, parent, tkv.context)
+	return &traceIterator{parent: parent, store: tkv}
 }
 
 type traceIterator struct {
-	parent  types.Iterator
-	writer  io.Writer
-	context types.TraceContext
-}
-
-func newTraceIterator(w io.Writer, parent types.Iterator, tc types.TraceContext) types.Iterator {
-	return &traceIterator{writer: w, parent: parent, context: tc}
+	parent types.Iterator
+	store  *Store
 }
 
 // Domain implements the Iterator interface.
@@ -133,7 +148,7 @@ func (ti *traceIterator) Next() {
 func (ti *traceIterator) Key() []byte {
 	key := ti.parent.Key()
 
-	writeOperation(ti.writer, iterKeyOp, ti.context, key, nil)
+	ti.store.writeOperation(IterKeyOp, key, nil)
 	return key
 }
 
@@ -141,7 +156,7 @@ func (ti *traceIterator) Key() []byte {
 func (ti *traceIterator) Value() []byte {
 	value := ti.parent.Value()
 
-	writeOperation(ti.writer, iterValueOp, ti.context, nil, value)
+	ti.store.writeOperation(IterValueOp, nil, value)
 	return value
 }
 
@@ -174,16 +189,40 @@ func (tkv *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Ca
 }
 
 // writeOperation writes a KVStore operation to the underlying io.Writer as
-// JSON-encoded data where the key/value pair is base64 encoded.
-func writeOperation(w io.Writer, op operation, tc types.TraceContext, key, value []byte) {
-	traceOp := traceOperation{
-		Operation: op,
-		Key:       base64.StdEncoding.EncodeToString(key),
-		Value:     base64.StdEncoding.EncodeToString(value),
+// a line of JSON-encoded data where the key/value pair is base64 encoded.
+func (tkv *Store) writeOperation(op Operation, key, value []byte) {
+	traceOp := TraceOperation{
+		Operation:  op,
+		Key:        base64.StdEncoding.EncodeToString(key),
+		Value:      base64.StdEncoding.EncodeToString(value),
+		Persistent: tkv.persistent,
 	}
 
-	if tc != nil {
-		traceOp.Metadata = tc
+	for k, v := range tkv.context {
+		switch k {
+		case types.TraceContextKeyStore:
+			traceOp.Store = cast.ToString(v)
+
+		case types.TraceContextKeyBlockHeight:
+			traceOp.BlockHeight = cast.ToInt64(v)
+
+		case types.TraceContextKeyTxHash:
+			traceOp.TxHash = cast.ToString(v)
+
+		case types.TraceContextKeyMsgIndex:
+			// a nil index clears the one of a previous message
+			if v != nil {
+				msgIndex := cast.ToUint32(v)
+				traceOp.MsgIndex = &msgIndex
+			}
+
+		default:
+			if traceOp.Metadata == nil {
+				traceOp.Metadata = make(map[string]interface{})
+			}
+
+			traceOp.Metadata[k] = v
+		}
 	}
 
 	raw, err := json.Marshal(traceOp)
@@ -191,9 +230,9 @@ func writeOperation(w io.Writer, op operation, tc types.TraceContext, key, value
 		panic(errors.Wrap(err, "failed to serialize trace operation"))
 	}
 
-	if _, err := w.Write(raw); err != nil {
+	// write the line at once, so that concurrent or rotated writes do not
+	// split it
+	if _, err := tkv.writer.Write(append(raw, '\n')); err != nil {
 		panic(errors.Wrap(err, "failed to write trace operation"))
 	}
-
-	io.WriteString(w, "\n")
 }
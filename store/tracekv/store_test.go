@@ -2,6 +2,7 @@ package tracekv_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"testing"
@@ -10,9 +11,11 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -53,17 +56,17 @@ func TestTraceKVStoreGet(t *testing.T) {
 		{
 			key:           []byte{},
 			expectedValue: nil,
-			expectedOut:   "{\"operation\":\"read\",\"key\":\"\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedOut:   "{\"operation\":\"read\",\"key\":\"\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			key:           kvPairs[0].Key,
 			expectedValue: kvPairs[0].Value,
-			expectedOut:   "{\"operation\":\"read\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedOut:   "{\"operation\":\"read\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			key:           []byte("does-not-exist"),
 			expectedValue: nil,
-			expectedOut:   "{\"operation\":\"read\",\"key\":\"ZG9lcy1ub3QtZXhpc3Q=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedOut:   "{\"operation\":\"read\",\"key\":\"ZG9lcy1ub3QtZXhpc3Q=\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
 		},
 	}
 
@@ -88,17 +91,17 @@ func TestTraceKVStoreSet(t *testing.T) {
 		{
 			key:         kvPairs[0].Key,
 			value:       kvPairs[0].Value,
-			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			key:         kvPairs[1].Key,
 			value:       kvPairs[1].Value,
-			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDI=\",\"value\":\"dmFsdWUwMDAwMDAwMg==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDI=\",\"value\":\"dmFsdWUwMDAwMDAwMg==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			key:         kvPairs[2].Key,
 			value:       kvPairs[2].Value,
-			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDM=\",\"value\":\"dmFsdWUwMDAwMDAwMw==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDM=\",\"value\":\"dmFsdWUwMDAwMDAwMw==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 	}
 
@@ -126,11 +129,11 @@ func TestTraceKVStoreDelete(t *testing.T) {
 	}{
 		{
 			key:         []byte{},
-			expectedOut: "{\"operation\":\"delete\",\"key\":\"\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedOut: "{\"operation\":\"delete\",\"key\":\"\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			key:         kvPairs[0].Key,
-			expectedOut: "{\"operation\":\"delete\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedOut: "{\"operation\":\"delete\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
 		},
 	}
 
@@ -190,20 +193,20 @@ func TestTestTraceKVStoreIterator(t *testing.T) {
 		{
 			expectedKey:      kvPairs[0].Key,
 			expectedValue:    kvPairs[0].Value,
-			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
-			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
+			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			expectedKey:      kvPairs[1].Key,
 			expectedValue:    kvPairs[1].Value,
-			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDI=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
-			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMg==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDI=\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
+			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMg==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			expectedKey:      kvPairs[2].Key,
 			expectedValue:    kvPairs[2].Value,
-			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDM=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
-			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMw==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDM=\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
+			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMw==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 	}
 
@@ -246,20 +249,20 @@ func TestTestTraceKVStoreReverseIterator(t *testing.T) {
 		{
 			expectedKey:      kvPairs[2].Key,
 			expectedValue:    kvPairs[2].Value,
-			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDM=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
-			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMw==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDM=\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
+			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMw==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			expectedKey:      kvPairs[1].Key,
 			expectedValue:    kvPairs[1].Value,
-			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDI=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
-			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMg==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDI=\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
+			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMg==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 		{
 			expectedKey:      kvPairs[0].Key,
 			expectedValue:    kvPairs[0].Value,
-			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
-			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64}}\n",
+			expectedKeyOut:   "{\"operation\":\"iterKey\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"block_height\":64,\"persistent\":true}\n",
+			expectedvalueOut: "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"block_height\":64,\"persistent\":true}\n",
 		},
 	}
 
@@ -303,3 +306,47 @@ func TestTraceKVStoreCacheWrapWithTrace(t *testing.T) {
 	store := newEmptyTraceKVStore(nil)
 	require.Panics(t, func() { store.CacheWrapWithTrace(nil, nil) })
 }
+
+func TestTraceKVStoreTraceContext(t *testing.T) {
+	var buf bytes.Buffer
+
+	tc := types.TraceContext{
+		types.TraceContextKeyStore:       "bank",
+		types.TraceContextKeyBlockHeight: int64(7),
+		types.TraceContextKeyTxHash:      "ABCD",
+		types.TraceContextKeyMsgIndex:    1,
+		"module":                         "staking",
+	}
+
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	tracekv.NewStore(memDB, &buf, tc).Set(bz("k"), bz("v"))
+
+	var op tracekv.TraceOperation
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &op))
+	require.Equal(t, tracekv.WriteOp, op.Operation)
+	require.Equal(t, "bank", op.Store)
+	require.Equal(t, int64(7), op.BlockHeight)
+	require.Equal(t, "ABCD", op.TxHash)
+	require.NotNil(t, op.MsgIndex)
+	require.Equal(t, uint32(1), *op.MsgIndex)
+	require.True(t, op.Persistent)
+	require.Equal(t, map[string]interface{}{"module": "staking"}, op.Metadata)
+
+	// a nil message index is cleared
+	buf.Reset()
+	tc[types.TraceContextKeyMsgIndex] = nil
+	tracekv.NewStore(memDB, &buf, tc).Set(bz("k"), bz("v"))
+	op = tracekv.TraceOperation{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &op))
+	require.Nil(t, op.MsgIndex)
+
+	// operations on a cache are not persistent
+	buf.Reset()
+	tracekv.NewStore(cachekv.NewStore(memDB), &buf, nil).Set(bz("k"), bz("v"))
+	require.Equal(t, "{\"operation\":\"write\",\"key\":\"aw==\",\"value\":\"dg==\"}\n", buf.String())
+
+	// nor the ones on a transient store
+	buf.Reset()
+	tracekv.NewStore(transient.NewStore(), &buf, nil).Set(bz("k"), bz("v"))
+	require.NotContains(t, buf.String(), "persistent")
+}
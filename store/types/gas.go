@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"math"
+	"sort"
 )
 
 // Gas consumption descriptors.
@@ -179,3 +180,173 @@ func TransientGasConfig() GasConfig {
 	// TODO: define gasconfig for transient stores
 	return KVGasConfig()
 }
+
+// Gas operation kinds recorded by a DetailedGasMeter. Store reads and writes
+// are charged by the gas KVStore, while flat gas is consumed directly on the
+// meter, e.g. for signature verification or the transaction size.
+const (
+	GasOperationRead    = "read"
+	GasOperationWrite   = "write"
+	GasOperationIterate = "iterate"
+	GasOperationFlat    = "flat"
+)
+
+// GasScopeKind defines what a DetailedGasMeter attributes consumed gas to.
+type GasScopeKind int
+
+const (
+	// GasScopeNone attributes gas neither to an ante decorator nor to a message.
+	GasScopeNone GasScopeKind = iota
+	// GasScopeAnteDecorator attributes gas to the named ante decorator.
+	GasScopeAnteDecorator
+	// GasScopeMsg attributes gas to the message at MsgIndex.
+	GasScopeMsg
+)
+
+// GasScope defines the ante decorator or message that a DetailedGasMeter
+// currently attributes consumed gas to.
+type GasScope struct {
+	Kind     GasScopeKind
+	Name     string
+	MsgIndex int
+}
+
+// GasConsumption defines the gas consumed under a given name.
+type GasConsumption struct {
+	Name string
+	Gas  Gas
+}
+
+// GasBreakdown defines the gas recorded by a DetailedGasMeter per store key,
+// per operation kind, per ante decorator and per message. Stores, operations
+// and ante decorators are sorted by name, while messages are ordered by their
+// index in the transaction and named after their type.
+type GasBreakdown struct {
+	Stores         []GasConsumption
+	Operations     []GasConsumption
+	AnteDecorators []GasConsumption
+	Msgs           []GasConsumption
+}
+
+// DetailedGasMeter is a GasMeter that additionally records where gas is
+// consumed. Gas consumed through ConsumeGas is recorded as flat gas, while the
+// gas KVStore records the store key and operation kind via ConsumeStoreGas.
+type DetailedGasMeter interface {
+	GasMeter
+
+	ConsumeStoreGas(amount Gas, descriptor, storeName, operation string)
+	Scope() GasScope
+	SetScope(scope GasScope)
+	GasBreakdown() GasBreakdown
+
+	// Wrap returns a DetailedGasMeter around the given GasMeter which shares
+	// the recorded breakdown and scope of this one. It allows replacing the
+	// meter of a context, e.g. when setting the transaction gas limit, without
+	// losing what has been recorded so far.
+	Wrap(meter GasMeter) DetailedGasMeter
+}
+
+type gasRecorder struct {
+	scope          GasScope
+	stores         map[string]Gas
+	operations     map[string]Gas
+	anteDecorators map[string]Gas
+	msgs           []GasConsumption
+}
+
+func (r *gasRecorder) record(amount Gas, storeName, operation string) {
+	if storeName != "" {
+		r.stores[storeName] += amount
+	}
+	r.operations[operation] += amount
+
+	switch r.scope.Kind {
+	case GasScopeAnteDecorator:
+		r.anteDecorators[r.scope.Name] += amount
+
+	case GasScopeMsg:
+		for len(r.msgs) <= r.scope.MsgIndex {
+			r.msgs = append(r.msgs, GasConsumption{})
+		}
+		r.msgs[r.scope.MsgIndex].Name = r.scope.Name
+		r.msgs[r.scope.MsgIndex].Gas += amount
+	}
+}
+
+type detailedGasMeter struct {
+	GasMeter
+	recorder *gasRecorder
+}
+
+// NewDetailedGasMeter returns a DetailedGasMeter which consumes gas on the
+// given GasMeter and records a breakdown of it.
+func NewDetailedGasMeter(meter GasMeter) DetailedGasMeter {
+	return &detailedGasMeter{
+		GasMeter: meter,
+		recorder: &gasRecorder{
+			stores:         make(map[string]Gas),
+			operations:     make(map[string]Gas),
+			anteDecorators: make(map[string]Gas),
+		},
+	}
+}
+
+// ConsumeGas consumes gas on the underlying meter and records it as flat gas.
+// Gas is recorded before it is consumed, so that gas which runs the meter out
+// is part of the breakdown as it is part of the consumed gas.
+func (g *detailedGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	g.recorder.record(amount, "", GasOperationFlat)
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (g *detailedGasMeter) ConsumeStoreGas(amount Gas, descriptor, storeName, operation string) {
+	g.recorder.record(amount, storeName, operation)
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (g *detailedGasMeter) Scope() GasScope {
+	return g.recorder.scope
+}
+
+func (g *detailedGasMeter) SetScope(scope GasScope) {
+	g.recorder.scope = scope
+}
+
+func (g *detailedGasMeter) GasBreakdown() GasBreakdown {
+	msgs := make([]GasConsumption, len(g.recorder.msgs))
+	copy(msgs, g.recorder.msgs)
+
+	return GasBreakdown{
+		Stores:         sortedGasConsumptions(g.recorder.stores),
+		Operations:     sortedGasConsumptions(g.recorder.operations),
+		AnteDecorators: sortedGasConsumptions(g.recorder.anteDecorators),
+		Msgs:           msgs,
+	}
+}
+
+func (g *detailedGasMeter) Wrap(meter GasMeter) DetailedGasMeter {
+	if dgm, ok := meter.(*detailedGasMeter); ok {
+		meter = dgm.GasMeter
+	}
+
+	return &detailedGasMeter{GasMeter: meter, recorder: g.recorder}
+}
+
+func (g *detailedGasMeter) String() string {
+	return fmt.Sprintf("DetailedGasMeter:\n  %s", g.GasMeter.String())
+}
+
+func sortedGasConsumptions(m map[string]Gas) []GasConsumption {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	consumptions := make([]GasConsumption, len(names))
+	for i, name := range names {
+		consumptions[i] = GasConsumption{Name: name, Gas: m[name]}
+	}
+
+	return consumptions
+}
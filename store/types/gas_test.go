@@ -102,3 +102,34 @@ func TestTransientGasConfig(t *testing.T) {
 		IterNextCostFlat: 30,
 	})
 }
+
+func TestDetailedGasMeter(t *testing.T) {
+	t.Parallel()
+	meter := NewDetailedGasMeter(NewInfiniteGasMeter())
+
+	meter.ConsumeGas(5, "unscoped")
+	meter.SetScope(GasScope{Kind: GasScopeAnteDecorator, Name: "ante"})
+	meter.ConsumeGas(10, "flat")
+	meter.ConsumeStoreGas(20, "read", "acc", GasOperationRead)
+
+	// replacing the underlying meter keeps the breakdown and the scope
+	limited := meter.Wrap(NewGasMeter(100))
+	require.Equal(t, GasScope{Kind: GasScopeAnteDecorator, Name: "ante"}, limited.Scope())
+
+	limited.SetScope(GasScope{Kind: GasScopeMsg, Name: "send", MsgIndex: 1})
+	limited.ConsumeStoreGas(30, "write", "bank", GasOperationWrite)
+	limited.ConsumeStoreGas(40, "iterate", "acc", GasOperationIterate)
+	require.Equal(t, uint64(70), limited.GasConsumed())
+	require.Panics(t, func() { limited.ConsumeGas(31, "out of gas") })
+
+	expected := GasBreakdown{
+		Stores: []GasConsumption{{"acc", 60}, {"bank", 30}},
+		Operations: []GasConsumption{
+			{GasOperationFlat, 46}, {GasOperationIterate, 40}, {GasOperationRead, 20}, {GasOperationWrite, 30},
+		},
+		AnteDecorators: []GasConsumption{{"ante", 30}},
+		Msgs:           []GasConsumption{{"", 0}, {"send", 101}},
+	}
+	require.Equal(t, expected, meter.GasBreakdown())
+	require.Equal(t, expected, limited.GasBreakdown())
+}
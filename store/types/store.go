@@ -164,6 +164,22 @@ type CommitMultiStore interface {
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// SetCommitWorkers sets the number of stores committed concurrently. A
+	// value of one or less commits stores serially.
+	SetCommitWorkers(workers int)
+
+	// SetArchiving sets whether the writes of each committed version are
+	// archived in a flat layout serving historical reads.
+	SetArchiving(enabled bool)
+
+	// SetArchiveReset sets whether an archive out of line with the loaded
+	// version is reset instead of failing the load.
+	SetArchiveReset(reset bool)
+
+	// RollbackToVersion reverts the multistore to a previous version, deleting
+	// all newer versions, and loads it.
+	RollbackToVersion(version int64) error
 }
 
 //---------subsp-------------------------------
@@ -389,6 +405,29 @@ type KVPair tmkv.Pair
 // every trace operation.
 type TraceContext map[string]interface{}
 
+// Well-known keys of a TraceContext, which trace operations report in their own
+// fields rather than as free-form metadata.
+const (
+	TraceContextKeyStore       = "store"
+	TraceContextKeyBlockHeight = "blockHeight"
+	TraceContextKeyTxHash      = "txHash"
+	TraceContextKeyMsgIndex    = "msgIndex"
+)
+
+// Merge returns a new TraceContext holding the entries of both contexts, the
+// ones of the given context overwriting existing keys.
+func (tc TraceContext) Merge(other TraceContext) TraceContext {
+	merged := make(TraceContext, len(tc)+len(other))
+	for k, v := range tc {
+		merged[k] = v
+	}
+	for k, v := range other {
+		merged[k] = v
+	}
+
+	return merged
+}
+
 // MultiStorePersistentCache defines an interface which provides inter-block
 // (persistent) caching capabilities for multiple CommitKVStores based on StoreKeys.
 type MultiStorePersistentCache interface {
@@ -247,6 +247,9 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	// GasBreakdown details where the gas was consumed. It is only set for
+	// simulated txs.
+	GasBreakdown *GasBreakdown `protobuf:"bytes,3,opt,name=gas_breakdown,json=gasBreakdown,proto3" json:"gas_breakdown,omitempty" yaml:"gas_breakdown,omitempty"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -295,6 +298,135 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetGasBreakdown() *GasBreakdown {
+	if m != nil {
+		return m.GasBreakdown
+	}
+	return nil
+}
+
+// GasBreakdown defines the gas consumed by a tx per store key, per operation
+// kind (read, write, iterate or flat), per ante decorator and per message.
+type GasBreakdown struct {
+	Stores         []GasConsumption `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores"`
+	Operations     []GasConsumption `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations"`
+	AnteDecorators []GasConsumption `protobuf:"bytes,3,rep,name=ante_decorators,json=anteDecorators,proto3" json:"ante_decorators" yaml:"ante_decorators"`
+	// Msgs are ordered by their index in the tx and named after their type.
+	Msgs []GasConsumption `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs"`
+}
+
+func (m *GasBreakdown) Reset()      { *m = GasBreakdown{} }
+func (*GasBreakdown) ProtoMessage() {}
+func (*GasBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_809e58c688fefd51, []int{6}
+}
+func (m *GasBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasBreakdown.Merge(m, src)
+}
+func (m *GasBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasBreakdown proto.InternalMessageInfo
+
+func (m *GasBreakdown) GetStores() []GasConsumption {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+func (m *GasBreakdown) GetOperations() []GasConsumption {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *GasBreakdown) GetAnteDecorators() []GasConsumption {
+	if m != nil {
+		return m.AnteDecorators
+	}
+	return nil
+}
+
+func (m *GasBreakdown) GetMsgs() []GasConsumption {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// GasConsumption defines the gas consumed under a given name.
+type GasConsumption struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Gas  uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *GasConsumption) Reset()      { *m = GasConsumption{} }
+func (*GasConsumption) ProtoMessage() {}
+func (*GasConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_809e58c688fefd51, []int{7}
+}
+func (m *GasConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasConsumption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasConsumption.Merge(m, src)
+}
+func (m *GasConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasConsumption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasConsumption proto.InternalMessageInfo
+
+func (m *GasConsumption) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GasConsumption) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be length
@@ -310,7 +442,7 @@ type Result struct {
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{6}
+	return fileDescriptor_809e58c688fefd51, []int{8}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +481,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{7}
+	return fileDescriptor_809e58c688fefd51, []int{9}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +526,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{8}
+	return fileDescriptor_809e58c688fefd51, []int{10}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,12 +573,15 @@ func (m *MsgData) GetData() []byte {
 // each message.
 type TxData struct {
 	Data []*MsgData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// MsgResults contains the Result of each message, in order. The events of a
+	// message result have a msg_index attribute holding the index of the message.
+	MsgResults []Result `protobuf:"bytes,2,rep,name=msg_results,json=msgResults,proto3" json:"msg_results" yaml:"msg_results"`
 }
 
 func (m *TxData) Reset()      { *m = TxData{} }
 func (*TxData) ProtoMessage() {}
 func (*TxData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{9}
+	return fileDescriptor_809e58c688fefd51, []int{11}
 }
 func (m *TxData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,6 +617,13 @@ func (m *TxData) GetData() []*MsgData {
 	return nil
 }
 
+func (m *TxData) GetMsgResults() []Result {
+	if m != nil {
+		return m.MsgResults
+	}
+	return nil
+}
+
 // TxResponse defines a structure containing relevant tx data and metadata. The
 // tags are stringified and the log is JSON decoded.
 type TxResponse struct {
@@ -497,12 +639,15 @@ type TxResponse struct {
 	GasUsed   int64           `protobuf:"varint,10,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 	Tx        *types1.Any     `protobuf:"bytes,11,opt,name=tx,proto3" json:"tx,omitempty"`
 	Timestamp string          `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// MsgResults contains the Result of each message of the tx, decoded from its
+	// data.
+	MsgResults []Result `protobuf:"bytes,13,rep,name=msg_results,json=msgResults,proto3" json:"msg_results"`
 }
 
 func (m *TxResponse) Reset()      { *m = TxResponse{} }
 func (*TxResponse) ProtoMessage() {}
 func (*TxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{10}
+	return fileDescriptor_809e58c688fefd51, []int{12}
 }
 func (m *TxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +688,7 @@ type ABCIMessageLog struct {
 func (m *ABCIMessageLog) Reset()      { *m = ABCIMessageLog{} }
 func (*ABCIMessageLog) ProtoMessage() {}
 func (*ABCIMessageLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{11}
+	return fileDescriptor_809e58c688fefd51, []int{13}
 }
 func (m *ABCIMessageLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +748,7 @@ type StringEvent struct {
 func (m *StringEvent) Reset()      { *m = StringEvent{} }
 func (*StringEvent) ProtoMessage() {}
 func (*StringEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{12}
+	return fileDescriptor_809e58c688fefd51, []int{14}
 }
 func (m *StringEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +801,7 @@ type Attribute struct {
 func (m *Attribute) Reset()      { *m = Attribute{} }
 func (*Attribute) ProtoMessage() {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{13}
+	return fileDescriptor_809e58c688fefd51, []int{15}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -706,6 +851,8 @@ func init() {
 	proto.RegisterType((*DecProto)(nil), "cosmos.DecProto")
 	proto.RegisterType((*ValAddresses)(nil), "cosmos.ValAddresses")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.GasInfo")
+	proto.RegisterType((*GasBreakdown)(nil), "cosmos.GasBreakdown")
+	proto.RegisterType((*GasConsumption)(nil), "cosmos.GasConsumption")
 	proto.RegisterType((*Result)(nil), "cosmos.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.MsgData")
@@ -719,63 +866,75 @@ func init() {
 func init() { proto.RegisterFile("cosmos/cosmos.proto", fileDescriptor_809e58c688fefd51) }
 
 var fileDescriptor_809e58c688fefd51 = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0x7a, 0xb7, 0x6b, 0xfb, 0xb5, 0x9b, 0xd0, 0x49, 0x68, 0x37, 0xa5, 0xf5, 0x9a, 0x2d,
-	0x42, 0x41, 0x82, 0xb5, 0x94, 0x56, 0x42, 0xf2, 0x01, 0x29, 0x1b, 0xa3, 0x62, 0xd4, 0x4a, 0x68,
-	0x1b, 0x40, 0xe2, 0x12, 0xc6, 0xde, 0xc9, 0x78, 0x55, 0xef, 0x8c, 0xe5, 0x19, 0x37, 0xf6, 0xad,
-	0x07, 0x0e, 0x3d, 0xf2, 0x13, 0x7a, 0xe6, 0x97, 0xf4, 0x98, 0x63, 0x85, 0x90, 0x01, 0xe7, 0xc2,
-	0xb9, 0x47, 0x4e, 0x68, 0x66, 0xd6, 0x6b, 0x3b, 0xe2, 0xd4, 0x4b, 0xf2, 0x7e, 0x7f, 0x3c, 0xef,
-	0xe3, 0x59, 0xd8, 0x1b, 0x70, 0x91, 0x71, 0xd1, 0x36, 0xff, 0xc2, 0xf1, 0x84, 0x4b, 0x8e, 0x5c,
-	0xa3, 0xdd, 0xdd, 0xa7, 0x9c, 0x72, 0x6d, 0x6a, 0x2b, 0xc9, 0x78, 0xef, 0x7e, 0x2c, 0x09, 0x4b,
-	0xc8, 0x24, 0x4b, 0x99, 0x6c, 0xe3, 0xfe, 0x20, 0x6d, 0xcb, 0xf9, 0x98, 0x08, 0xf3, 0x37, 0x0f,
-	0x39, 0xa0, 0x9c, 0xd3, 0x11, 0x69, 0x6b, 0xad, 0x3f, 0x3d, 0x6f, 0x63, 0x36, 0x37, 0xae, 0xe0,
-	0x31, 0x38, 0x27, 0x3c, 0x65, 0x68, 0x1f, 0x6e, 0x24, 0x84, 0xf1, 0xcc, 0xb3, 0x5a, 0xd6, 0x61,
-	0x2d, 0x36, 0x0a, 0x7a, 0x00, 0x2e, 0xce, 0xf8, 0x94, 0x49, 0xaf, 0xac, 0xcc, 0x51, 0xfd, 0xcd,
-	0xc2, 0x2f, 0xfd, 0xbe, 0xf0, 0xed, 0x1e, 0x93, 0x71, 0xee, 0xea, 0x38, 0xff, 0xbc, 0xf6, 0xad,
-	0xe0, 0x5b, 0xa8, 0x74, 0xc9, 0xe0, 0x7d, 0x6a, 0x75, 0xc9, 0xe0, 0x5a, 0xad, 0xcf, 0xa0, 0xda,
-	0x63, 0xf2, 0x3b, 0xbd, 0xfc, 0x7d, 0xb0, 0x53, 0x26, 0x4d, 0xa9, 0xed, 0xfe, 0xca, 0xae, 0x42,
-	0xbb, 0x64, 0x50, 0x84, 0x26, 0x64, 0x70, 0x3d, 0x54, 0x95, 0x57, 0xf6, 0x20, 0x82, 0xc6, 0x0f,
-	0x78, 0x74, 0x9c, 0x24, 0x13, 0x22, 0x04, 0x11, 0xe8, 0x73, 0xa8, 0xe1, 0x95, 0xe2, 0x59, 0x2d,
-	0xfb, 0xb0, 0x11, 0xed, 0xfc, 0xbb, 0xf0, 0x61, 0x1d, 0x14, 0xaf, 0x03, 0x3a, 0xce, 0xcb, 0x3f,
-	0x5a, 0x56, 0xc0, 0xa1, 0xf2, 0x18, 0x8b, 0x1e, 0x3b, 0xe7, 0xe8, 0x11, 0x00, 0xc5, 0xe2, 0xec,
-	0x02, 0x33, 0x49, 0x12, 0xdd, 0xd4, 0x89, 0x3e, 0x7c, 0xb7, 0xf0, 0x6f, 0xcd, 0x71, 0x36, 0xea,
-	0x04, 0x6b, 0x5f, 0x10, 0xd7, 0x28, 0x16, 0x3f, 0x6a, 0x19, 0x85, 0x50, 0x55, 0x9e, 0xa9, 0x20,
-	0x89, 0xc6, 0xc1, 0x89, 0xf6, 0xde, 0x2d, 0xfc, 0xdd, 0x75, 0x8e, 0xf2, 0x04, 0x71, 0x85, 0x62,
-	0xf1, 0xbd, 0x92, 0xc6, 0xe0, 0xc6, 0x44, 0x4c, 0x47, 0x12, 0x21, 0x70, 0x12, 0x2c, 0xb1, 0xee,
-	0xd4, 0x88, 0xb5, 0x8c, 0x3e, 0x00, 0x7b, 0xc4, 0xa9, 0x01, 0x34, 0x56, 0x22, 0xea, 0x80, 0x4b,
-	0x5e, 0x10, 0x26, 0x85, 0x67, 0xb7, 0xec, 0xc3, 0xfa, 0xd1, 0xbd, 0x70, 0x4d, 0x8f, 0x50, 0xd1,
-	0x23, 0x34, 0xc4, 0xf8, 0x5a, 0x05, 0x45, 0x8e, 0x02, 0x29, 0xce, 0x33, 0x3a, 0xce, 0xab, 0xd7,
-	0x7e, 0x29, 0x98, 0x00, 0x7a, 0x96, 0x66, 0xd3, 0x11, 0x96, 0x29, 0x67, 0x31, 0x11, 0x63, 0xce,
-	0x04, 0x41, 0x8f, 0xcc, 0xdc, 0x29, 0x3b, 0xe7, 0x7a, 0x82, 0xfa, 0xd1, 0x6e, 0x98, 0x93, 0x34,
-	0x07, 0x24, 0xaa, 0xaa, 0x62, 0x97, 0x0b, 0xdf, 0xd2, 0xd3, 0x6b, 0x8c, 0x3e, 0x05, 0x77, 0xa2,
-	0xa7, 0xd7, 0x23, 0xd6, 0x8f, 0x76, 0x56, 0x39, 0x66, 0xa7, 0x38, 0xf7, 0x06, 0x5f, 0x41, 0xe5,
-	0xa9, 0xa0, 0x5d, 0xb5, 0xd2, 0x01, 0x54, 0x33, 0x41, 0xcf, 0xd4, 0x94, 0x39, 0x7f, 0x2a, 0x99,
-	0xa0, 0xa7, 0xf3, 0x31, 0x29, 0x10, 0x28, 0xaf, 0x11, 0xc8, 0xcf, 0xf2, 0x10, 0xdc, 0xd3, 0x99,
-	0x4e, 0x7f, 0x50, 0xa0, 0x64, 0x6f, 0xce, 0x98, 0x57, 0xdf, 0x4a, 0xfa, 0xc5, 0x06, 0x38, 0x9d,
-	0x15, 0x1b, 0xde, 0x06, 0x77, 0x48, 0x52, 0x3a, 0x34, 0x5c, 0xb3, 0xe3, 0x5c, 0x43, 0x01, 0xb8,
-	0x72, 0x36, 0xc4, 0x62, 0x98, 0xf3, 0x16, 0x96, 0x0b, 0xdf, 0x3d, 0x9d, 0x7d, 0x83, 0xc5, 0x30,
-	0xce, 0x3d, 0xe8, 0x1e, 0xd4, 0x06, 0x3c, 0x21, 0x62, 0x8c, 0x07, 0xc4, 0xb3, 0xf5, 0xd4, 0x6b,
-	0x83, 0x9a, 0x5b, 0x29, 0x9e, 0xd3, 0xb2, 0x0e, 0x6f, 0xc6, 0x5a, 0x2e, 0x76, 0xb9, 0xa1, 0x83,
-	0xcd, 0x35, 0xef, 0x40, 0x65, 0x82, 0x2f, 0xce, 0xd4, 0x45, 0x5d, 0x6d, 0x76, 0x27, 0xf8, 0xe2,
-	0x09, 0xa7, 0xe8, 0x04, 0x9c, 0x11, 0xa7, 0xc2, 0xab, 0xe8, 0xa5, 0x6e, 0xaf, 0x96, 0x3a, 0x8e,
-	0x4e, 0x7a, 0x4f, 0x89, 0x10, 0x98, 0x92, 0x27, 0x9c, 0x46, 0x77, 0x14, 0xfe, 0xbf, 0xfd, 0xe9,
-	0xef, 0x6e, 0xdb, 0x45, 0xac, 0x93, 0x55, 0x47, 0x7d, 0xbd, 0xaa, 0xe9, 0xa8, 0x64, 0x74, 0x7f,
-	0x8b, 0xc3, 0x35, 0xbd, 0xf7, 0x06, 0x59, 0x0f, 0x36, 0xc8, 0x0a, 0xda, 0xb9, 0xe2, 0x25, 0xfa,
-	0x04, 0xca, 0x72, 0xe6, 0xd5, 0xf5, 0x55, 0xf7, 0x43, 0xf3, 0xbe, 0x84, 0xab, 0xf7, 0x25, 0x3c,
-	0x66, 0xf3, 0xb8, 0x2c, 0x67, 0x0a, 0x17, 0x99, 0x66, 0x44, 0x48, 0x9c, 0x8d, 0xbd, 0x86, 0xc1,
-	0xa5, 0x30, 0xe4, 0x7c, 0x7b, 0x65, 0xc1, 0xce, 0xf6, 0xc4, 0xe8, 0x23, 0xa8, 0x29, 0x0e, 0xa4,
-	0x2c, 0x21, 0x33, 0x7d, 0x8d, 0x9b, 0xb1, 0x22, 0x45, 0x4f, 0xe9, 0xff, 0xc3, 0xf9, 0xe3, 0x6b,
-	0x9c, 0xdf, 0x5b, 0x01, 0xf4, 0x4c, 0x4e, 0x52, 0x46, 0x0d, 0xd5, 0xf7, 0x73, 0x74, 0x1a, 0x1b,
-	0x46, 0xb1, 0xa6, 0xbe, 0x66, 0xc4, 0xcf, 0x50, 0xdf, 0xf0, 0x2a, 0xc4, 0x36, 0x68, 0xa8, 0x65,
-	0xf4, 0x25, 0x00, 0x96, 0x72, 0x92, 0xf6, 0xa7, 0x92, 0x08, 0xaf, 0xac, 0xfb, 0xdd, 0x2a, 0x0e,
-	0xb2, 0xf2, 0xe4, 0x3f, 0xac, 0x8d, 0xd0, 0x82, 0xa8, 0xb5, 0x22, 0x48, 0x6d, 0xf2, 0x9c, 0xcc,
-	0xf3, 0xf2, 0x4a, 0x54, 0x2f, 0xe7, 0x0b, 0x3c, 0x9a, 0x92, 0x7c, 0x3b, 0xa3, 0x44, 0xdd, 0xb7,
-	0x7f, 0x37, 0x4b, 0x2f, 0x97, 0xcd, 0xd2, 0x9b, 0x65, 0xd3, 0xba, 0x5c, 0x36, 0xad, 0xbf, 0x96,
-	0x4d, 0xeb, 0xd7, 0xab, 0x66, 0xe9, 0xf2, 0xaa, 0x59, 0x7a, 0x7b, 0xd5, 0x2c, 0xfd, 0x14, 0xd0,
-	0x54, 0x0e, 0xa7, 0xfd, 0x70, 0xc0, 0xb3, 0xf6, 0xd6, 0x17, 0xe4, 0x0b, 0x91, 0x3c, 0x37, 0x9f,
-	0x82, 0xbe, 0xab, 0xaf, 0xf3, 0xf0, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x86, 0x78, 0x6d,
-	0x63, 0x06, 0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0xdb, 0x75, 0xfc, 0xec, 0x24, 0xdf, 0x4e, 0xf2, 0x4d, 0x37, 0xa1, 0xf5, 0x86,
+	0x2d, 0x42, 0x41, 0x2a, 0x36, 0x4a, 0x03, 0x48, 0x11, 0x42, 0xca, 0xc6, 0x28, 0x04, 0x5a, 0x09,
+	0x6d, 0x03, 0x48, 0x48, 0x28, 0x8c, 0xbd, 0x93, 0xf1, 0x2a, 0xde, 0x1d, 0x6b, 0x67, 0xdc, 0xd8,
+	0xb7, 0x1c, 0x7b, 0xe4, 0xc2, 0xbd, 0x67, 0xfe, 0x05, 0xfe, 0x81, 0x9e, 0x50, 0x8e, 0x15, 0x42,
+	0x06, 0x92, 0x0b, 0xe7, 0x1c, 0x39, 0xa1, 0x99, 0xd9, 0x5d, 0xaf, 0x43, 0x85, 0x2a, 0x2e, 0xc9,
+	0x9b, 0xf7, 0x73, 0xde, 0x67, 0x3e, 0xef, 0xad, 0x61, 0xa5, 0xc7, 0x78, 0xc4, 0x78, 0x5b, 0xff,
+	0x6b, 0x0d, 0x13, 0x26, 0x18, 0xb2, 0xf4, 0x69, 0x63, 0x95, 0x32, 0xca, 0x94, 0xaa, 0x2d, 0x25,
+	0x6d, 0xdd, 0x78, 0x53, 0x90, 0x38, 0x20, 0x49, 0x14, 0xc6, 0xa2, 0x8d, 0xbb, 0xbd, 0xb0, 0x2d,
+	0x26, 0x43, 0xc2, 0xf5, 0xdf, 0xd4, 0x65, 0x9d, 0x32, 0x46, 0x07, 0xa4, 0xad, 0x4e, 0xdd, 0xd1,
+	0x49, 0x1b, 0xc7, 0x13, 0x6d, 0x72, 0x0f, 0xc0, 0xdc, 0x67, 0x61, 0x8c, 0x56, 0xe1, 0x56, 0x40,
+	0x62, 0x16, 0xd9, 0xc6, 0xa6, 0xb1, 0x55, 0xf3, 0xf5, 0x01, 0xdd, 0x07, 0x0b, 0x47, 0x6c, 0x14,
+	0x0b, 0xbb, 0x2c, 0xd5, 0x5e, 0xfd, 0xc5, 0xd4, 0x29, 0xfd, 0x32, 0x75, 0x2a, 0x87, 0xb1, 0xf0,
+	0x53, 0xd3, 0xae, 0xf9, 0xe7, 0x73, 0xc7, 0x70, 0x3f, 0x83, 0x6a, 0x87, 0xf4, 0xfe, 0x4b, 0xae,
+	0x0e, 0xe9, 0xdd, 0xc8, 0xf5, 0x0e, 0x2c, 0x1c, 0xc6, 0xe2, 0x0b, 0xd5, 0xfc, 0x3d, 0xa8, 0x84,
+	0xb1, 0xb0, 0x8d, 0xf9, 0x18, 0x59, 0x5f, 0xea, 0xa5, 0x6b, 0x87, 0xf4, 0x72, 0xd7, 0x80, 0xf4,
+	0x6c, 0xe3, 0x9f, 0xe9, 0xa5, 0xde, 0xf5, 0xa0, 0xf1, 0x15, 0x1e, 0xec, 0x05, 0x41, 0x42, 0x38,
+	0x27, 0x1c, 0x3d, 0x80, 0x1a, 0xce, 0x0e, 0xb6, 0xb1, 0x59, 0xd9, 0x6a, 0x78, 0x4b, 0x7f, 0x4d,
+	0x1d, 0x98, 0x39, 0xf9, 0x33, 0x87, 0x5d, 0xf3, 0xfc, 0xd7, 0x4d, 0xc3, 0xfd, 0xd9, 0x80, 0xea,
+	0x01, 0xe6, 0x87, 0xf1, 0x09, 0x43, 0x3b, 0x00, 0x14, 0xf3, 0xe3, 0x33, 0x1c, 0x0b, 0x12, 0xa8,
+	0xaa, 0xa6, 0xf7, 0xff, 0xeb, 0xa9, 0x73, 0x7b, 0x82, 0xa3, 0xc1, 0xae, 0x3b, 0xb3, 0xb9, 0x7e,
+	0x8d, 0x62, 0xfe, 0xb5, 0x92, 0x51, 0x0b, 0x16, 0xa4, 0x65, 0xc4, 0x49, 0xa0, 0x80, 0x30, 0xbd,
+	0x95, 0xeb, 0xa9, 0xb3, 0x3c, 0x8b, 0x91, 0x16, 0xd7, 0xaf, 0x52, 0xcc, 0xbf, 0xe4, 0x24, 0x40,
+	0xdf, 0xc2, 0xa2, 0xd4, 0x76, 0x13, 0x82, 0x4f, 0x03, 0x76, 0x16, 0xdb, 0x95, 0x4d, 0x63, 0xab,
+	0xbe, 0xbd, 0xda, 0x4a, 0x29, 0x72, 0x80, 0xb9, 0x97, 0xd9, 0x3c, 0xf7, 0x7a, 0xea, 0x34, 0x67,
+	0xa9, 0xf2, 0xa0, 0x07, 0x2c, 0x0a, 0x05, 0x89, 0x86, 0x62, 0xe2, 0xfa, 0x0d, 0x5a, 0x88, 0x70,
+	0x7f, 0x28, 0x43, 0xa3, 0x98, 0x02, 0xed, 0x80, 0xc5, 0x05, 0x4b, 0x52, 0x48, 0xea, 0xdb, 0x6b,
+	0x85, 0x42, 0xfb, 0x2c, 0xe6, 0xa3, 0x68, 0x28, 0x42, 0x16, 0x7b, 0xa6, 0xc4, 0xd7, 0x4f, 0x7d,
+	0xd1, 0x47, 0x00, 0x6c, 0x48, 0x12, 0x2c, 0x4d, 0xdc, 0x2e, 0xbf, 0x46, 0x64, 0xc1, 0x1f, 0x1d,
+	0xc3, 0xb2, 0x04, 0xe7, 0x38, 0x20, 0x3d, 0x96, 0x60, 0xc1, 0x12, 0x6e, 0x57, 0xfe, 0x35, 0x45,
+	0x53, 0xa6, 0xb8, 0x9e, 0x3a, 0x6b, 0xba, 0xd7, 0x1b, 0xc1, 0xae, 0xbf, 0x24, 0x35, 0x9d, 0x5c,
+	0x81, 0xde, 0x03, 0x33, 0xe2, 0x94, 0xdb, 0xe6, 0x6b, 0x5c, 0x4c, 0x79, 0xba, 0x1f, 0xc0, 0xd2,
+	0xbc, 0x15, 0x21, 0x30, 0x63, 0x1c, 0x91, 0x94, 0xd4, 0x4a, 0x46, 0xff, 0x83, 0x0a, 0xc5, 0x5c,
+	0xbf, 0xa3, 0x2f, 0x45, 0x77, 0x08, 0x96, 0x4f, 0xf8, 0x68, 0x20, 0xa4, 0x7f, 0x80, 0x05, 0x56,
+	0xfe, 0x0d, 0x5f, 0xc9, 0xd2, 0x7f, 0xc0, 0xa8, 0x1e, 0x00, 0x5f, 0x8a, 0x68, 0x17, 0x2c, 0xf2,
+	0x94, 0xc4, 0x22, 0xeb, 0xf8, 0x6e, 0x6b, 0x36, 0xce, 0x2d, 0x39, 0xce, 0x2d, 0x3d, 0xc8, 0x9f,
+	0x48, 0xa7, 0x0c, 0x74, 0x1d, 0xb1, 0x6b, 0x3e, 0x7b, 0xee, 0x94, 0xdc, 0x04, 0xd0, 0x93, 0x30,
+	0x1a, 0x0d, 0x14, 0x96, 0x3e, 0xe1, 0x43, 0x16, 0x73, 0x82, 0x76, 0x34, 0xcd, 0xc2, 0xf8, 0x84,
+	0xa9, 0x1b, 0xd4, 0xb7, 0x97, 0x0b, 0x5d, 0x4b, 0xfe, 0x7a, 0x0b, 0x32, 0xd9, 0xc5, 0xd4, 0x31,
+	0x14, 0xd9, 0xa4, 0x0a, 0xbd, 0x0d, 0x56, 0xa2, 0x6e, 0xaf, 0xae, 0x58, 0xdf, 0x5e, 0xca, 0x62,
+	0x74, 0x4f, 0x7e, 0x6a, 0x75, 0x3f, 0x86, 0xea, 0x63, 0x4e, 0x3b, 0xb2, 0xa5, 0x75, 0x58, 0x88,
+	0x38, 0x3d, 0x96, 0xb7, 0x4c, 0xa1, 0xa9, 0x46, 0x9c, 0x1e, 0x4d, 0x86, 0x24, 0x47, 0xa0, 0x3c,
+	0x43, 0x20, 0x1d, 0xa3, 0x73, 0x03, 0xac, 0xa3, 0xb1, 0x8a, 0xbf, 0x9f, 0xc3, 0x54, 0x29, 0x5e,
+	0x32, 0x4d, 0x9f, 0xe2, 0xf6, 0x39, 0xd4, 0x65, 0x11, 0x5d, 0x3d, 0xe3, 0xd7, 0x8d, 0xcb, 0x79,
+	0x1b, 0x29, 0x29, 0x90, 0x26, 0x45, 0x21, 0xc0, 0xf5, 0x21, 0xe2, 0x54, 0xbb, 0x65, 0x93, 0xfc,
+	0x53, 0x05, 0xe0, 0x68, 0x9c, 0xe3, 0xb5, 0x06, 0x56, 0x9f, 0x84, 0xb4, 0xaf, 0x37, 0x4d, 0xc5,
+	0x4f, 0x4f, 0xc8, 0x05, 0x4b, 0x8c, 0xfb, 0x98, 0xf7, 0xd3, 0xad, 0x05, 0x97, 0x53, 0xc7, 0x3a,
+	0x1a, 0x7f, 0x8a, 0x79, 0xdf, 0x4f, 0x2d, 0xe8, 0x2e, 0xd4, 0x7a, 0x2c, 0x20, 0x7c, 0x88, 0x7b,
+	0x44, 0x8d, 0x67, 0xcd, 0x9f, 0x29, 0x24, 0x0a, 0xf2, 0x60, 0x9b, 0x9b, 0xc6, 0xd6, 0xa2, 0xaf,
+	0xe4, 0x1c, 0x99, 0x5b, 0x9a, 0x4b, 0xaa, 0xc7, 0x3b, 0x50, 0x4d, 0xf0, 0xd9, 0xb1, 0xe4, 0x87,
+	0xa5, 0xd4, 0x56, 0x82, 0xcf, 0x1e, 0x31, 0x8a, 0xf6, 0xc1, 0x1c, 0x30, 0xca, 0xed, 0xea, 0x3c,
+	0x79, 0xf7, 0xbc, 0xfd, 0xc3, 0xc7, 0x84, 0x73, 0x4c, 0xc9, 0x23, 0x46, 0xbd, 0x3b, 0xb2, 0xfb,
+	0x1f, 0x7f, 0x73, 0x96, 0xe7, 0xf5, 0xdc, 0x57, 0xc1, 0xb2, 0xa2, 0xe2, 0xc2, 0x82, 0xae, 0x28,
+	0x65, 0x74, 0x6f, 0x6e, 0x81, 0xd5, 0x54, 0xdf, 0x85, 0x4d, 0xb5, 0x5e, 0xd8, 0x54, 0xa0, 0x8c,
+	0xf9, 0x52, 0x7a, 0x0b, 0xca, 0x62, 0x6c, 0xd7, 0xd3, 0x4d, 0xa4, 0xbf, 0x2e, 0xad, 0xec, 0xeb,
+	0xd2, 0xda, 0x8b, 0x27, 0x7e, 0x59, 0x8c, 0x25, 0x2e, 0x22, 0x8c, 0x08, 0x17, 0x38, 0x1a, 0xda,
+	0x0d, 0x8d, 0x4b, 0xae, 0x40, 0xef, 0xcf, 0xbf, 0xe9, 0xe2, 0x2b, 0xdf, 0x34, 0xdd, 0x15, 0xc5,
+	0xd7, 0x53, 0xa4, 0x7f, 0x66, 0xc0, 0xd2, 0x7c, 0xa3, 0xe8, 0x0d, 0xa8, 0xc9, 0x7c, 0x61, 0x1c,
+	0x90, 0xb1, 0x7a, 0xc4, 0x45, 0x5f, 0x32, 0xf3, 0x50, 0x9e, 0x5f, 0x31, 0x78, 0x7b, 0x37, 0x06,
+	0x6f, 0x25, 0xab, 0xfc, 0x44, 0x24, 0x61, 0x4c, 0xf5, 0xbc, 0xad, 0xa6, 0xa0, 0x36, 0x0a, 0x4a,
+	0x3e, 0x9b, 0x3f, 0x45, 0xa4, 0xef, 0xa0, 0x5e, 0xb0, 0x4a, 0xa0, 0x0b, 0xb3, 0xa0, 0x64, 0xf4,
+	0x21, 0x00, 0x16, 0x22, 0x09, 0xbb, 0x23, 0x41, 0x32, 0xf6, 0xde, 0xce, 0xdf, 0x31, 0xb3, 0x64,
+	0xcd, 0xce, 0x5c, 0xd3, 0x0a, 0x0f, 0xa1, 0x96, 0x3b, 0xc9, 0x4e, 0x4e, 0xc9, 0x24, 0x4d, 0x2f,
+	0x45, 0xf9, 0xb9, 0x7d, 0x8a, 0x07, 0x23, 0x92, 0x76, 0xa7, 0x0f, 0x5e, 0xe7, 0xe5, 0x1f, 0xcd,
+	0xd2, 0xf9, 0x65, 0xb3, 0xf4, 0xe2, 0xb2, 0x69, 0x5c, 0x5c, 0x36, 0x8d, 0xdf, 0x2f, 0x9b, 0xc6,
+	0xf7, 0x57, 0xcd, 0xd2, 0xc5, 0x55, 0xb3, 0xf4, 0xf2, 0xaa, 0x59, 0xfa, 0xc6, 0xa5, 0xa1, 0xe8,
+	0x8f, 0xba, 0xad, 0x1e, 0x8b, 0xda, 0x73, 0x3f, 0x3b, 0xde, 0xe5, 0xc1, 0xa9, 0xfe, 0xfd, 0xd0,
+	0xb5, 0xd4, 0xa3, 0x3e, 0xfc, 0x7b, 0x00, 0x85, 0x49, 0x5d, 0x24, 0x98, 0x08, 0x00, 0x00,
 }
 
 func (this *Coin) Equal(that interface{}) bool {
@@ -1030,6 +1189,18 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasBreakdown != nil {
+		{
+			size, err := m.GasBreakdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCosmos(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintCosmos(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -1043,6 +1214,120 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AnteDecorators) > 0 {
+		for iNdEx := len(m.AnteDecorators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnteDecorators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasConsumption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasConsumption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintCosmos(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCosmos(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1196,6 +1481,20 @@ func (m *TxData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1233,6 +1532,20 @@ func (m *TxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.Timestamp) > 0 {
 		i -= len(m.Timestamp)
 		copy(dAtA[i:], m.Timestamp)
@@ -1544,6 +1857,59 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovCosmos(uint64(m.GasUsed))
 	}
+	if m.GasBreakdown != nil {
+		l = m.GasBreakdown.Size()
+		n += 1 + l + sovCosmos(uint64(l))
+	}
+	return n
+}
+
+func (m *GasBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
+	if len(m.AnteDecorators) > 0 {
+		for _, e := range m.AnteDecorators {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCosmos(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovCosmos(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -1614,6 +1980,12 @@ func (m *TxData) Size() (n int) {
 			n += 1 + l + sovCosmos(uint64(l))
 		}
 	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1669,6 +2041,12 @@ func (m *TxResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCosmos(uint64(l))
 	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1766,8 +2144,14 @@ func (this *TxData) String() string {
 		repeatedStringForData += strings.Replace(f.String(), "MsgData", "MsgData", 1) + ","
 	}
 	repeatedStringForData += "}"
+	repeatedStringForMsgResults := "[]Result{"
+	for _, f := range this.MsgResults {
+		repeatedStringForMsgResults += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForMsgResults += "}"
 	s := strings.Join([]string{`&TxData{`,
 		`Data:` + repeatedStringForData + `,`,
+		`MsgResults:` + repeatedStringForMsgResults + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2377,6 +2761,335 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasBreakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasBreakdown == nil {
+				m.GasBreakdown = &GasBreakdown{}
+			}
+			if err := m.GasBreakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, GasConsumption{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, GasConsumption{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnteDecorators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnteDecorators = append(m.AnteDecorators, GasConsumption{})
+			if err := m.AnteDecorators[len(m.AnteDecorators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, GasConsumption{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasConsumption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasConsumption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosmos(dAtA[iNdEx:])
@@ -2858,6 +3571,40 @@ func (m *TxData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, Result{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosmos(dAtA[iNdEx:])
@@ -3249,6 +3996,40 @@ func (m *TxResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Timestamp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, Result{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosmos(dAtA[iNdEx:])
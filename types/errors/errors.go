@@ -106,6 +106,18 @@ var (
 	// ErrInvalidType defines an error an invalid type.
 	ErrInvalidType = Register(RootCodespace, 29, "invalid type")
 
+	// ErrQueryOutOfGas defines an error when a query exceeds the query gas limit
+	// of the node.
+	ErrQueryOutOfGas = Register(RootCodespace, 30, "query out of gas")
+
+	// ErrQueryTimeout defines an error when a query exceeds the query timeout of
+	// the node.
+	ErrQueryTimeout = Register(RootCodespace, 31, "query timed out")
+
+	// ErrCircuitTripped defines an error when a message is disabled by the
+	// circuit breaker.
+	ErrCircuitTripped = Register(RootCodespace, 32, "message is disabled by the circuit breaker")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
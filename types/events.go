@@ -91,6 +91,20 @@ func (e Event) AppendAttributes(attrs ...Attribute) Event {
 	return e
 }
 
+// AppendAttributes returns a copy of the events with one or more attributes
+// added to each of them. The attributes of the given events are left untouched.
+func (e Events) AppendAttributes(attrs ...Attribute) Events {
+	res := make(Events, len(e))
+	for i, ev := range e {
+		attributes := make([]tmkv.Pair, len(ev.Attributes), len(ev.Attributes)+len(attrs))
+		copy(attributes, ev.Attributes)
+
+		res[i] = Event{Type: ev.Type, Attributes: attributes}.AppendAttributes(attrs...)
+	}
+
+	return res
+}
+
 // AppendEvent adds an Event to a slice of events.
 func (e Events) AppendEvent(event Event) Events {
 	return append(e, event)
@@ -131,6 +145,10 @@ var (
 	AttributeKeyModule = "module"
 	AttributeKeySender = "sender"
 	AttributeKeyAmount = "amount"
+
+	// AttributeKeyMsgIndex is added by BaseApp to the events emitted while
+	// running each message of a tx, with the index of the message as value.
+	AttributeKeyMsgIndex = "msg_index"
 )
 
 type (
@@ -25,6 +25,24 @@ func TestAppendAttributes(t *testing.T) {
 	require.Equal(t, e, NewEvent("transfer", NewAttribute("sender", "foo"), NewAttribute("recipient", "bar")))
 }
 
+func TestEventsAppendAttributes(t *testing.T) {
+	e1 := NewEvent("transfer", NewAttribute("sender", "foo"))
+	e2 := NewEvent("message", NewAttribute("action", "send"))
+	events := Events{e1, e2}
+
+	res := events.AppendAttributes(NewAttribute("msg_index", "1"))
+	require.Equal(t, Events{
+		NewEvent("transfer", NewAttribute("sender", "foo"), NewAttribute("msg_index", "1")),
+		NewEvent("message", NewAttribute("action", "send"), NewAttribute("msg_index", "1")),
+	}, res)
+
+	// the original events are left untouched
+	require.Equal(t, Events{
+		NewEvent("transfer", NewAttribute("sender", "foo")),
+		NewEvent("message", NewAttribute("action", "send")),
+	}, events)
+}
+
 func TestEmptyEvents(t *testing.T) {
 	require.Equal(t, EmptyEvents(), Events{})
 }
@@ -0,0 +1,44 @@
+package feeestimate
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type queryServer struct {
+	oracle       *Oracle
+	minGasPrices func() sdk.DecCoins
+}
+
+var _ QueryServer = queryServer{}
+
+// NewQueryServer returns a QueryServer answering from the given Oracle.
+// minGasPrices returns the node's current minimum gas prices.
+func NewQueryServer(oracle *Oracle, minGasPrices func() sdk.DecCoins) QueryServer {
+	return queryServer{oracle: oracle, minGasPrices: minGasPrices}
+}
+
+// GasPrices implements the Query/GasPrices gRPC method
+func (qs queryServer) GasPrices(_ context.Context, req *QueryGasPricesRequest) (*QueryGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Percentile > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid percentile %d; must be at most 100", req.Percentile)
+	}
+
+	minGasPrices := qs.minGasPrices()
+	gasPrices, numBlocks, numTxs := qs.oracle.Estimate(req.Percentile, minGasPrices)
+
+	return &QueryGasPricesResponse{
+		GasPrices:     gasPrices,
+		MinGasPrices:  minGasPrices,
+		SampledBlocks: numBlocks,
+		SampledTxs:    numTxs,
+	}, nil
+}
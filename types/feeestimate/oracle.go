@@ -0,0 +1,125 @@
+package feeestimate
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultPercentile defines the percentile of sampled gas prices recommended
+// when a request does not specify one.
+const DefaultPercentile = 50
+
+// Oracle samples the effective gas prices, i.e. fee divided by gas limit, of
+// the transactions committed in the most recent blocks. It is node-local and
+// does not affect consensus. An Oracle is safe for concurrent use.
+type Oracle struct {
+	mtx sync.RWMutex
+
+	maxBlocks int
+	// blocks holds the samples of the last committed blocks, oldest first
+	blocks [][]sdk.DecCoins
+	// pending holds the samples of the block currently being executed
+	pending []sdk.DecCoins
+}
+
+// NewOracle returns a new Oracle keeping samples for the given number of
+// most recent blocks.
+func NewOracle(maxBlocks int) *Oracle {
+	if maxBlocks <= 0 {
+		panic("fee estimation must sample at least one block")
+	}
+
+	return &Oracle{
+		maxBlocks: maxBlocks,
+		blocks:    make([][]sdk.DecCoins, 0, maxBlocks),
+	}
+}
+
+// RecordTx records the effective gas prices of a successfully delivered
+// transaction paying the given fee for the given gas limit. Transactions
+// without a fee or gas limit are ignored.
+func (o *Oracle) RecordTx(fee sdk.Coins, gasLimit uint64) {
+	if fee.IsZero() || gasLimit == 0 {
+		return
+	}
+
+	glDec := sdk.NewDec(int64(gasLimit))
+	prices := make(sdk.DecCoins, len(fee))
+
+	for i, coin := range fee {
+		prices[i] = sdk.NewDecCoinFromDec(coin.Denom, coin.Amount.ToDec().Quo(glDec))
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.pending = append(o.pending, prices)
+}
+
+// Commit closes the samples of the current block, evicting the oldest block
+// once more than the configured number of blocks has been sampled.
+func (o *Oracle) Commit() {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	if len(o.blocks) == o.maxBlocks {
+		o.blocks = append(o.blocks[:0], o.blocks[1:]...)
+	}
+
+	o.blocks = append(o.blocks, o.pending)
+	o.pending = nil
+}
+
+// Estimate returns, for every denomination seen in the sampled blocks or in
+// minGasPrices, the given percentile of the sampled gas prices floored at the
+// corresponding minimum gas price. It also returns the number of blocks and
+// transactions the estimate is based on.
+func (o *Oracle) Estimate(percentile uint32, minGasPrices sdk.DecCoins) (sdk.DecCoins, uint64, uint64) {
+	if percentile == 0 || percentile > 100 {
+		percentile = DefaultPercentile
+	}
+
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	var numTxs uint64
+
+	samples := make(map[string][]sdk.Dec)
+	for _, block := range o.blocks {
+		numTxs += uint64(len(block))
+
+		for _, prices := range block {
+			for _, price := range prices {
+				samples[price.Denom] = append(samples[price.Denom], price.Amount)
+			}
+		}
+	}
+
+	estimates := sdk.NewDecCoins()
+	for denom, amounts := range samples {
+		sort.Slice(amounts, func(i, j int) bool { return amounts[i].LT(amounts[j]) })
+
+		// nearest-rank percentile
+		rank := (int(percentile)*len(amounts) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+
+		estimate := amounts[rank-1]
+		if minPrice := minGasPrices.AmountOf(denom); estimate.LT(minPrice) {
+			estimate = minPrice
+		}
+
+		estimates = estimates.Add(sdk.NewDecCoinFromDec(denom, estimate))
+	}
+
+	for _, minPrice := range minGasPrices {
+		if _, ok := samples[minPrice.Denom]; !ok && minPrice.IsPositive() {
+			estimates = estimates.Add(minPrice)
+		}
+	}
+
+	return estimates, uint64(len(o.blocks)), numTxs
+}
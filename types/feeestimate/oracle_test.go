@@ -0,0 +1,83 @@
+package feeestimate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/feeestimate"
+)
+
+func TestOracleEstimate(t *testing.T) {
+	oracle := feeestimate.NewOracle(2)
+
+	prices, blocks, txs := oracle.Estimate(0, nil)
+	require.True(t, prices.Empty())
+	require.Zero(t, blocks)
+	require.Zero(t, txs)
+
+	// block 1: 1stake, 2stake per gas
+	oracle.RecordTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 100)
+	oracle.RecordTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), 100)
+	// ignored
+	oracle.RecordTx(sdk.NewCoins(), 100)
+	oracle.RecordTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), 0)
+	oracle.Commit()
+
+	prices, blocks, txs = oracle.Estimate(0, nil)
+	require.Equal(t, uint64(1), blocks)
+	require.Equal(t, uint64(2), txs)
+	require.Equal(t, sdk.NewDec(1), prices.AmountOf("stake"))
+
+	prices, _, _ = oracle.Estimate(100, nil)
+	require.Equal(t, sdk.NewDec(2), prices.AmountOf("stake"))
+
+	// block 2: 4stake per gas, 1photon per gas
+	oracle.RecordTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 400), sdk.NewInt64Coin("photon", 100)), 100)
+	oracle.Commit()
+
+	prices, blocks, txs = oracle.Estimate(100, nil)
+	require.Equal(t, uint64(2), blocks)
+	require.Equal(t, uint64(3), txs)
+	require.Equal(t, sdk.NewDec(4), prices.AmountOf("stake"))
+	require.Equal(t, sdk.NewDec(1), prices.AmountOf("photon"))
+
+	// minimum gas prices floor the estimate and add unsampled denominations
+	minGasPrices := sdk.NewDecCoins(sdk.NewInt64DecCoin("photon", 3), sdk.NewInt64DecCoin("atom", 5))
+	prices, _, _ = oracle.Estimate(50, minGasPrices)
+	require.Equal(t, sdk.NewDec(2), prices.AmountOf("stake"))
+	require.Equal(t, sdk.NewDec(3), prices.AmountOf("photon"))
+	require.Equal(t, sdk.NewDec(5), prices.AmountOf("atom"))
+
+	// block 1 is evicted
+	oracle.Commit()
+
+	prices, blocks, txs = oracle.Estimate(0, nil)
+	require.Equal(t, uint64(2), blocks)
+	require.Equal(t, uint64(1), txs)
+	require.Equal(t, sdk.NewDec(4), prices.AmountOf("stake"))
+}
+
+func TestQueryServerGasPrices(t *testing.T) {
+	oracle := feeestimate.NewOracle(1)
+	oracle.RecordTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 100)
+	oracle.Commit()
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1))
+	qs := feeestimate.NewQueryServer(oracle, func() sdk.DecCoins { return minGasPrices })
+
+	_, err := qs.GasPrices(context.Background(), nil)
+	require.Error(t, err)
+
+	_, err = qs.GasPrices(context.Background(), &feeestimate.QueryGasPricesRequest{Percentile: 101})
+	require.Error(t, err)
+
+	res, err := qs.GasPrices(context.Background(), &feeestimate.QueryGasPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, minGasPrices, res.MinGasPrices)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1), sdk.NewInt64DecCoin("stake", 1)), res.GasPrices)
+	require.Equal(t, uint64(1), res.SampledBlocks)
+	require.Equal(t, uint64(1), res.SampledTxs)
+}
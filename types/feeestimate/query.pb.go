@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feeestimate/query.proto
+
+package feeestimate
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryGasPricesRequest is the request type for the Query/GasPrices RPC method
+type QueryGasPricesRequest struct {
+	// percentile of the sampled gas prices to recommend, between 1 and 100. If
+	// zero, the median is used.
+	Percentile uint32 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+}
+
+func (m *QueryGasPricesRequest) Reset()         { *m = QueryGasPricesRequest{} }
+func (m *QueryGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPricesRequest) ProtoMessage()    {}
+func (*QueryGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83bf8382ba9885, []int{0}
+}
+func (m *QueryGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPricesRequest.Merge(m, src)
+}
+func (m *QueryGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPricesRequest proto.InternalMessageInfo
+
+func (m *QueryGasPricesRequest) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+// QueryGasPricesResponse is the response type for the Query/GasPrices RPC method
+type QueryGasPricesResponse struct {
+	// gas_prices are the recommended gas prices, one per denomination. They are
+	// never lower than the node's minimum gas prices.
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+	// min_gas_prices are the minimum gas prices configured on the queried node.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
+	// sampled_blocks is the number of committed blocks the estimate is based on.
+	SampledBlocks uint64 `protobuf:"varint,3,opt,name=sampled_blocks,json=sampledBlocks,proto3" json:"sampled_blocks,omitempty"`
+	// sampled_txs is the number of transactions the estimate is based on.
+	SampledTxs uint64 `protobuf:"varint,4,opt,name=sampled_txs,json=sampledTxs,proto3" json:"sampled_txs,omitempty"`
+}
+
+func (m *QueryGasPricesResponse) Reset()         { *m = QueryGasPricesResponse{} }
+func (m *QueryGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPricesResponse) ProtoMessage()    {}
+func (*QueryGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83bf8382ba9885, []int{1}
+}
+func (m *QueryGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPricesResponse.Merge(m, src)
+}
+func (m *QueryGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryGasPricesResponse) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func (m *QueryGasPricesResponse) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *QueryGasPricesResponse) GetSampledBlocks() uint64 {
+	if m != nil {
+		return m.SampledBlocks
+	}
+	return 0
+}
+
+func (m *QueryGasPricesResponse) GetSampledTxs() uint64 {
+	if m != nil {
+		return m.SampledTxs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryGasPricesRequest)(nil), "cosmos.feeestimate.QueryGasPricesRequest")
+	proto.RegisterType((*QueryGasPricesResponse)(nil), "cosmos.feeestimate.QueryGasPricesResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/feeestimate/query.proto", fileDescriptor_ae83bf8382ba9885) }
+
+var fileDescriptor_ae83bf8382ba9885 = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x4d, 0x4f, 0xfa, 0x40,
+	0x10, 0xc6, 0x5b, 0xe0, 0xff, 0x4f, 0x58, 0x04, 0x93, 0xf5, 0x25, 0x0d, 0x87, 0x85, 0x90, 0x98,
+	0xa0, 0xc6, 0x36, 0x81, 0x83, 0x77, 0x34, 0xd1, 0xa3, 0x36, 0x9e, 0xbc, 0x90, 0x52, 0xc6, 0xba,
+	0xc2, 0x76, 0x4b, 0x67, 0x49, 0xe0, 0x5b, 0xf8, 0x39, 0xbc, 0xf9, 0x2d, 0x38, 0x72, 0xf4, 0xa4,
+	0x06, 0xbe, 0x88, 0x61, 0x29, 0x58, 0x5f, 0x12, 0x3d, 0x78, 0x6b, 0x7e, 0xf3, 0xcc, 0x3c, 0xdd,
+	0x79, 0x86, 0x30, 0x5f, 0xa2, 0x90, 0xe8, 0xdc, 0x00, 0x00, 0x2a, 0x2e, 0x3c, 0x05, 0xce, 0x60,
+	0x08, 0xf1, 0xd8, 0x8e, 0x62, 0xa9, 0x24, 0xa5, 0xcb, 0xba, 0x9d, 0xaa, 0x97, 0xb7, 0x03, 0x19,
+	0x48, 0x5d, 0x76, 0x16, 0x5f, 0x4b, 0x65, 0x79, 0x2b, 0x99, 0x94, 0x34, 0x68, 0x58, 0x3b, 0x26,
+	0x3b, 0x97, 0x8b, 0x69, 0x67, 0x1e, 0x5e, 0xc4, 0xdc, 0x07, 0x74, 0x61, 0x30, 0x04, 0x54, 0x94,
+	0x11, 0x12, 0x41, 0xec, 0x43, 0xa8, 0x78, 0x1f, 0x2c, 0xb3, 0x6a, 0xd6, 0x8b, 0x6e, 0x8a, 0xd4,
+	0x1e, 0x33, 0x64, 0xf7, 0x73, 0x27, 0x46, 0x32, 0x44, 0xa0, 0x3e, 0x21, 0x81, 0x87, 0xed, 0x48,
+	0x53, 0xcb, 0xac, 0x66, 0xeb, 0x85, 0xc6, 0xa6, 0x9d, 0xd8, 0x9e, 0x82, 0x7f, 0x22, 0x79, 0xd8,
+	0x6a, 0x4e, 0x9e, 0x2b, 0xc6, 0xc3, 0x4b, 0xe5, 0x30, 0xe0, 0xea, 0x76, 0xd8, 0xb1, 0x7d, 0x29,
+	0x9c, 0x0f, 0x3f, 0x78, 0x84, 0xdd, 0x9e, 0xa3, 0xc6, 0x11, 0xac, 0x7b, 0xd0, 0xcd, 0x07, 0x2b,
+	0x33, 0x7a, 0x47, 0x4a, 0x82, 0x87, 0xed, 0x94, 0x51, 0xe6, 0x0f, 0x8d, 0x36, 0x04, 0x0f, 0xd7,
+	0x0f, 0xa3, 0x7b, 0xa4, 0x84, 0x9e, 0x88, 0xfa, 0xd0, 0x6d, 0x77, 0xfa, 0xd2, 0xef, 0xa1, 0x95,
+	0xad, 0x9a, 0xf5, 0x9c, 0x5b, 0x4c, 0x68, 0x4b, 0x43, 0x5a, 0x21, 0x85, 0x95, 0x4c, 0x8d, 0xd0,
+	0xca, 0x69, 0x0d, 0x49, 0xd0, 0xd5, 0x08, 0x1b, 0x82, 0xfc, 0xd3, 0x2b, 0xa3, 0x5d, 0x92, 0x7f,
+	0x9f, 0xbe, 0x6f, 0x7f, 0x8d, 0xd0, 0xfe, 0x36, 0x94, 0xf2, 0xc1, 0x6f, 0xa4, 0xcb, 0x14, 0x6a,
+	0x46, 0xeb, 0x7c, 0x32, 0x63, 0xe6, 0x74, 0xc6, 0xcc, 0xd7, 0x19, 0x33, 0xef, 0xe7, 0xcc, 0x98,
+	0xce, 0x99, 0xf1, 0x34, 0x67, 0xc6, 0xb5, 0xfd, 0xf3, 0x2e, 0xd2, 0x07, 0xd7, 0xf9, 0xaf, 0x8f,
+	0xa5, 0xf9, 0x36, 0x00, 0x8a, 0x9f, 0x76, 0x8d, 0x8d, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// GasPrices returns recommended gas prices based on the effective gas prices
+	// of recently committed transactions and the node's minimum gas prices.
+	GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error) {
+	out := new(QueryGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feeestimate.Query/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// GasPrices returns recommended gas prices based on the effective gas prices
+	// of recently committed transactions and the node's minimum gas prices.
+	GasPrices(context.Context, *QueryGasPricesRequest) (*QueryGasPricesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *QueryGasPricesRequest) (*QueryGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feeestimate.Query/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrices(ctx, req.(*QueryGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.feeestimate.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GasPrices",
+			Handler:    _Query_GasPrices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/feeestimate/query.proto",
+}
+
+func (m *QueryGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SampledTxs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SampledTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SampledBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SampledBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovQuery(uint64(m.Percentile))
+	}
+	return n
+}
+
+func (m *QueryGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SampledBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.SampledBlocks))
+	}
+	if m.SampledTxs != 0 {
+		n += 1 + sovQuery(uint64(m.SampledTxs))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampledBlocks", wireType)
+			}
+			m.SampledBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampledBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampledTxs", wireType)
+			}
+			m.SampledTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampledTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) (*Result, error)
 
@@ -12,6 +14,19 @@ type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
 }
 
+// PostHandler runs after the messages of a transaction executed successfully,
+// e.g. to refund unused gas or to distribute tips. It runs in the same cached
+// context as the messages, so that failing it fails the whole transaction, and
+// may update their result. The gas used so far is the one consumed by the gas
+// meter of the context. Like the messages, it only runs in DeliverTx and
+// simulation. If newCtx.IsZero(), ctx is used instead.
+type PostHandler func(ctx Context, tx Tx, result *Result, simulate bool) (newCtx Context, err error)
+
+// PostDecorator wraps the next PostHandler to perform custom post-processing.
+type PostDecorator interface {
+	PostHandle(ctx Context, tx Tx, result *Result, simulate bool, next PostHandler) (newCtx Context, err error)
+}
+
 // ChainDecorator chains AnteDecorators together with each AnteDecorator
 // wrapping over the decorators further along chain and returns a single AnteHandler.
 //
@@ -37,11 +52,41 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 
 	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
+		// attribute the gas consumed by the decorator itself to it, restoring
+		// the scope of the enclosing decorator once it returns
+		if dgm, ok := ctx.GasMeter().(DetailedGasMeter); ok {
+			scope := dgm.Scope()
+			dgm.SetScope(GasScope{Kind: GasScopeAnteDecorator, Name: fmt.Sprintf("%T", chain[0])})
+			defer dgm.SetScope(scope)
+		}
+
 		return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
 	}
 }
 
-// Terminator AnteDecorator will get added to the chain to simplify decorator code
+// ChainPostDecorators chains PostDecorators together with each PostDecorator
+// wrapping over the decorators further along chain and returns a single
+// PostHandler.
+//
+// NOTE: The first element is outermost decorator, while the last element is
+// innermost decorator, as for ChainAnteDecorators.
+// Returns nil when no PostDecorator are supplied.
+func ChainPostDecorators(chain ...PostDecorator) PostHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	// handle non-terminated decorators chain
+	if (chain[len(chain)-1] != Terminator{}) {
+		chain = append(chain, Terminator{})
+	}
+
+	return func(ctx Context, tx Tx, result *Result, simulate bool) (Context, error) {
+		return chain[0].PostHandle(ctx, tx, result, simulate, ChainPostDecorators(chain[1:]...))
+	}
+}
+
+// Terminator AnteDecorator and PostDecorator will get added to the chain to simplify decorator code
 // Don't need to check if next == nil further up the chain
 //                        ______
 //                     <((((((\\\
@@ -64,3 +109,8 @@ type Terminator struct{}
 func (t Terminator) AnteHandle(ctx Context, _ Tx, _ bool, _ AnteHandler) (Context, error) {
 	return ctx, nil
 }
+
+// Simply return provided Context and nil error
+func (t Terminator) PostHandle(ctx Context, _ Tx, _ *Result, _ bool, _ PostHandler) (Context, error) {
+	return ctx, nil
+}
@@ -26,3 +26,68 @@ func TestChainAnteDecorators(t *testing.T) {
 	mockAnteDecorator2.EXPECT().AnteHandle(gomock.Eq(ctx), gomock.Eq(tx), true, nil).Times(1)
 	sdk.ChainAnteDecorators(mockAnteDecorator1, mockAnteDecorator2)
 }
+
+type recordPostDecorator struct {
+	name  string
+	calls *[]string
+}
+
+func (d recordPostDecorator) PostHandle(
+	ctx sdk.Context, tx sdk.Tx, result *sdk.Result, simulate bool, next sdk.PostHandler,
+) (sdk.Context, error) {
+	*d.calls = append(*d.calls, d.name)
+	result.Log += d.name
+
+	return next(ctx, tx, result, simulate)
+}
+
+func TestChainPostDecorators(t *testing.T) {
+	t.Parallel()
+	require.Nil(t, sdk.ChainPostDecorators([]sdk.PostDecorator{}...))
+
+	var calls []string
+	result := &sdk.Result{}
+	postHandler := sdk.ChainPostDecorators(
+		recordPostDecorator{"a", &calls}, recordPostDecorator{"b", &calls},
+	)
+
+	_, err := postHandler(sdk.Context{}, nil, result, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, calls)
+	require.Equal(t, "ab", result.Log)
+}
+
+type gasAnteDecorator struct {
+	gas sdk.Gas
+}
+
+func (d gasAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(d.gas, "before next")
+	newCtx, err := next(ctx, tx, simulate)
+	ctx.GasMeter().ConsumeGas(d.gas, "after next")
+
+	return newCtx, err
+}
+
+type otherGasAnteDecorator struct {
+	gasAnteDecorator
+}
+
+func TestChainAnteDecoratorsGasScope(t *testing.T) {
+	t.Parallel()
+	meter := sdk.NewDetailedGasMeter(sdk.NewInfiniteGasMeter())
+	ctx := sdk.Context{}.WithGasMeter(meter)
+
+	anteHandler := sdk.ChainAnteDecorators(gasAnteDecorator{10}, otherGasAnteDecorator{gasAnteDecorator{3}})
+	_, err := anteHandler(ctx, nil, true)
+	require.NoError(t, err)
+
+	breakdown := meter.GasBreakdown()
+	require.Equal(t, uint64(26), meter.GasConsumed())
+	require.Len(t, breakdown.AnteDecorators, 2)
+	require.Equal(t, "types_test.gasAnteDecorator", breakdown.AnteDecorators[0].Name)
+	require.Equal(t, uint64(20), breakdown.AnteDecorators[0].Gas)
+	require.Equal(t, "types_test.otherGasAnteDecorator", breakdown.AnteDecorators[1].Name)
+	require.Equal(t, uint64(6), breakdown.AnteDecorators[1].Gas)
+	require.Equal(t, sdk.GasScope{}, meter.Scope())
+}
@@ -0,0 +1,48 @@
+package multicall
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewCall returns a Call of the given fully-qualified gRPC method with the
+// given request message.
+func NewCall(method string, req proto.Message) (Call, error) {
+	any, err := codectypes.NewAnyWithValue(req)
+	if err != nil {
+		return Call{}, err
+	}
+
+	return Call{Method: method, Request: any}, nil
+}
+
+// Err returns the error the query failed with, or nil if it succeeded.
+func (r CallResult) Err() error {
+	if r.Code == sdkerrors.SuccessABCICode {
+		return nil
+	}
+
+	return sdkerrors.ABCIError(r.Codespace, r.Code, r.Log)
+}
+
+// UnpackResponse unmarshals the response of a successful query into res,
+// which must be of the response type of the called method.
+func (r CallResult) UnpackResponse(res proto.Message) error {
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	if r.Response == nil {
+		return fmt.Errorf("missing response")
+	}
+
+	if typeURL := "/" + proto.MessageName(res); r.Response.TypeUrl != typeURL {
+		return fmt.Errorf("response type %s does not match %s", r.Response.TypeUrl, typeURL)
+	}
+
+	return proto.Unmarshal(r.Response.Value, res)
+}
@@ -0,0 +1,138 @@
+package orm
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// IDSetter is implemented by the messages of an AutoIncrementTable to
+// receive their newly assigned ID.
+type IDSetter interface {
+	codec.ProtoMarshaler
+
+	SetID(id uint64)
+}
+
+// AutoIncrementTable is a table whose primary keys are sequential IDs,
+// starting at 1 and encoded in big-endian so that rows iterate in creation
+// order. Rows are only created by Create, which assigns them the next ID of
+// the sequence, so that IDs are never reused.
+type AutoIncrementTable struct {
+	table *Table
+
+	getID func(obj codec.ProtoMarshaler) uint64
+}
+
+// NewAutoIncrementTable returns an AutoIncrementTable storing messages of the
+// same type as model under the given prefix. getID returns the ID of a stored
+// message, as assigned by Create.
+func NewAutoIncrementTable(
+	prefix []byte, model IDSetter, cdc codec.BinaryMarshaler, getID func(obj codec.ProtoMarshaler) uint64,
+) *AutoIncrementTable {
+	t := &AutoIncrementTable{getID: getID}
+	t.table = NewTable(prefix, model, cdc, func(obj codec.ProtoMarshaler) []byte {
+		return IDKey(t.getID(obj))
+	})
+
+	return t
+}
+
+// AddIndex declares a non-unique secondary index of the table, see
+// Table.AddIndex.
+func (t *AutoIncrementTable) AddIndex(id byte, indexKey IndexKeyFunc) *Index {
+	return t.table.AddIndex(id, indexKey)
+}
+
+// AddUniqueIndex declares a unique secondary index of the table, see
+// Table.AddUniqueIndex.
+func (t *AutoIncrementTable) AddUniqueIndex(id byte, indexKey IndexKeyFunc) *Index {
+	return t.table.AddUniqueIndex(id, indexKey)
+}
+
+// Create assigns the next ID to obj and stores it. It returns the assigned ID.
+func (t *AutoIncrementTable) Create(store sdk.KVStore, obj IDSetter) (uint64, error) {
+	if err := t.table.assertModel(obj); err != nil {
+		return 0, err
+	}
+
+	id := t.Sequence(store) + 1
+	obj.SetID(id)
+
+	if err := t.table.Create(store, obj); err != nil {
+		return 0, err
+	}
+
+	store.Set(t.table.subPrefix(sequencePrefix), sdk.Uint64ToBigEndian(id))
+
+	return id, nil
+}
+
+// Update replaces a stored message by one with the same ID. It fails with
+// ErrInvalidKey if the ID of obj is zero, or with ErrNotFound if no message
+// with its ID is stored.
+func (t *AutoIncrementTable) Update(store sdk.KVStore, obj codec.ProtoMarshaler) error {
+	if err := t.table.assertModel(obj); err != nil {
+		return err
+	}
+
+	if t.getID(obj) == 0 {
+		return sdkerrors.Wrap(ErrInvalidKey, "zero ID")
+	}
+
+	return t.table.Update(store, obj)
+}
+
+// Delete removes the message with the given ID along with its index entries.
+// It fails with ErrNotFound if no such message is stored.
+func (t *AutoIncrementTable) Delete(store sdk.KVStore, id uint64) error {
+	return t.table.Delete(store, IDKey(id))
+}
+
+// Has returns whether a message with the given ID is stored.
+func (t *AutoIncrementTable) Has(store sdk.KVStore, id uint64) bool {
+	return t.table.Has(store, IDKey(id))
+}
+
+// GetByID loads the message with the given ID into dest.
+func (t *AutoIncrementTable) GetByID(store sdk.KVStore, id uint64, dest codec.ProtoMarshaler) error {
+	return t.table.Get(store, IDKey(id), dest)
+}
+
+// Iterator returns an Iterator over the messages with primary keys in
+// [start, end), in ascending order of ID, see Table.Iterator.
+func (t *AutoIncrementTable) Iterator(store sdk.KVStore, start, end []byte) Iterator {
+	return t.table.Iterator(store, start, end)
+}
+
+// ReverseIterator returns an Iterator over the messages with primary keys in
+// [start, end), in descending order of ID, see Table.ReverseIterator.
+func (t *AutoIncrementTable) ReverseIterator(store sdk.KVStore, start, end []byte) Iterator {
+	return t.table.ReverseIterator(store, start, end)
+}
+
+// Paginate paginates over the messages of the table in ascending order of ID,
+// see Table.Paginate.
+func (t *AutoIncrementTable) Paginate(
+	store sdk.KVStore,
+	pageRequest *query.PageRequest,
+	onResult func(pk []byte, obj codec.ProtoMarshaler) error,
+) (*query.PageResponse, error) {
+	return t.table.Paginate(store, pageRequest, onResult)
+}
+
+// Sequence returns the last assigned ID, or zero if none was assigned.
+func (t *AutoIncrementTable) Sequence(store sdk.KVStore) uint64 {
+	bz := store.Get(t.table.subPrefix(sequencePrefix))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IDKey returns the primary key of the given ID in an AutoIncrementTable.
+func IDKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
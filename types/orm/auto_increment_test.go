@@ -0,0 +1,83 @@
+package orm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/orm"
+)
+
+type customer struct {
+	testdata.Customer1
+}
+
+func (c *customer) SetID(id uint64) {
+	c.Id = int32(id)
+}
+
+func TestAutoIncrementTable(t *testing.T) {
+	cdc := codec.NewProtoCodec(testdata.NewTestInterfaceRegistry())
+	table := orm.NewAutoIncrementTable([]byte{0x03}, &customer{}, cdc, func(obj codec.ProtoMarshaler) uint64 {
+		return uint64(obj.(*customer).Id)
+	})
+	byName := table.AddUniqueIndex(0, func(obj codec.ProtoMarshaler) ([]byte, error) {
+		return []byte(obj.(*customer).Name), nil
+	})
+	store := newStore()
+
+	require.Equal(t, uint64(0), table.Sequence(store))
+
+	for i, name := range []string{"alice", "bob", "carol"} {
+		id, err := table.Create(store, &customer{testdata.Customer1{Name: name}})
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), id)
+	}
+
+	require.Equal(t, uint64(3), table.Sequence(store))
+
+	var c customer
+	require.NoError(t, table.GetByID(store, 2, &c))
+	require.Equal(t, "bob", c.Name)
+	require.Equal(t, int32(2), c.Id)
+
+	// a failed create does not consume an ID
+	_, err := table.Create(store, &customer{testdata.Customer1{Name: "bob"}})
+	require.True(t, orm.ErrUniqueConstraint.Is(err))
+	require.Equal(t, uint64(3), table.Sequence(store))
+
+	// IDs are not reused after deletion
+	require.NoError(t, table.Delete(store, 3))
+	require.False(t, table.Has(store, 3))
+	id, err := table.Create(store, &customer{testdata.Customer1{Name: "dave"}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), id)
+
+	pk, err := byName.Get(store, []byte("dave"), &c)
+	require.NoError(t, err)
+	require.Equal(t, orm.IDKey(4), pk)
+
+	objs, err := orm.ReadAll(table.Iterator(store, nil, nil), func() codec.ProtoMarshaler { return &customer{} })
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+	require.Equal(t, "alice", objs[0].(*customer).Name)
+	require.Equal(t, "dave", objs[2].(*customer).Name)
+
+	// only stored messages with a non-zero ID can be updated
+	err = table.Update(store, &customer{testdata.Customer1{Name: "erin"}})
+	require.True(t, orm.ErrInvalidKey.Is(err))
+	err = table.Update(store, &customer{testdata.Customer1{Id: 5, Name: "erin"}})
+	require.True(t, orm.ErrNotFound.Is(err))
+	require.False(t, table.Has(store, 5))
+
+	require.NoError(t, table.Update(store, &customer{testdata.Customer1{Id: 4, Name: "erin"}}))
+	require.NoError(t, table.GetByID(store, 4, &c))
+	require.Equal(t, "erin", c.Name)
+
+	// an ID set before creation is replaced by the next one of the sequence
+	id, err = table.Create(store, &customer{testdata.Customer1{Id: 9, Name: "frank"}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), id)
+}
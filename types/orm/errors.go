@@ -0,0 +1,18 @@
+package orm
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ModuleName defines the codespace of the ORM errors
+const ModuleName = "orm"
+
+// ORM errors
+var (
+	ErrNotFound         = sdkerrors.Register(ModuleName, 2, "not found")
+	ErrAlreadyExists    = sdkerrors.Register(ModuleName, 3, "already exists")
+	ErrUniqueConstraint = sdkerrors.Register(ModuleName, 4, "unique constraint violation")
+	ErrInvalidType      = sdkerrors.Register(ModuleName, 5, "invalid type")
+	ErrInvalidKey       = sdkerrors.Register(ModuleName, 6, "invalid key")
+	ErrIteratorDone     = sdkerrors.Register(ModuleName, 7, "iterator done")
+)
@@ -0,0 +1,133 @@
+package orm
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// IndexKeyFunc returns the key of a message in a secondary index, at most 255
+// bytes long. A nil key leaves the message out of the index.
+type IndexKeyFunc func(obj codec.ProtoMarshaler) ([]byte, error)
+
+// Index is a secondary index of a Table, mapping index keys to the primary
+// keys of the rows. Its entries are maintained by the Table on write.
+type Index struct {
+	table    *Table
+	id       byte
+	indexKey IndexKeyFunc
+	unique   bool
+}
+
+// Unique returns whether the index is unique.
+func (idx *Index) Unique() bool {
+	return idx.unique
+}
+
+// Has returns whether any row has the given index key.
+func (idx *Index) Has(store sdk.KVStore, key []byte) bool {
+	_, ok := idx.firstPrimaryKey(store, key)
+	return ok
+}
+
+// Get loads the row with the given key in a unique index into dest and
+// returns its primary key. It fails with ErrNotFound if no row has that key.
+func (idx *Index) Get(store sdk.KVStore, key []byte, dest codec.ProtoMarshaler) ([]byte, error) {
+	if !idx.unique {
+		return nil, sdkerrors.Wrap(ErrInvalidKey, "get requires a unique index; use an iterator instead")
+	}
+
+	pk, ok := idx.firstPrimaryKey(store, key)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrNotFound, "index %d key %X", idx.id, key)
+	}
+
+	return pk, idx.table.Get(store, pk, dest)
+}
+
+// Iterator returns an Iterator over the rows with the given index key, in
+// ascending order of primary key.
+func (idx *Index) Iterator(store sdk.KVStore, key []byte) Iterator {
+	return newIndexIterator(idx.table, store, idx.keyStore(store, key).Iterator(nil, nil))
+}
+
+// ReverseIterator returns an Iterator over the rows with the given index key,
+// in descending order of primary key.
+func (idx *Index) ReverseIterator(store sdk.KVStore, key []byte) Iterator {
+	return newIndexIterator(idx.table, store, idx.keyStore(store, key).ReverseIterator(nil, nil))
+}
+
+// Paginate paginates over the rows with the given index key, in ascending
+// order of primary key, using query.Paginate. onResult is called with the
+// primary key and a newly allocated message for every row of the page.
+func (idx *Index) Paginate(
+	store sdk.KVStore,
+	key []byte,
+	pageRequest *query.PageRequest,
+	onResult func(pk []byte, obj codec.ProtoMarshaler) error,
+) (*query.PageResponse, error) {
+	return query.Paginate(idx.keyStore(store, key), pageRequest, func(pk []byte, _ []byte) error {
+		obj := idx.table.newModel()
+		if err := idx.table.Get(store, pk, obj); err != nil {
+			return err
+		}
+
+		return onResult(pk, obj)
+	})
+}
+
+// rowKey returns the index key of a message, validating its length.
+func (idx *Index) rowKey(obj codec.ProtoMarshaler) ([]byte, error) {
+	key, err := idx.indexKey(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(key) > math.MaxUint8 {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "index key longer than %d bytes", math.MaxUint8)
+	}
+
+	return key, nil
+}
+
+// firstPrimaryKey returns the lowest primary key of the rows with the given
+// index key, if any.
+func (idx *Index) firstPrimaryKey(store sdk.KVStore, key []byte) ([]byte, bool) {
+	if len(key) > math.MaxUint8 {
+		return nil, false
+	}
+
+	it := idx.keyStore(store, key).Iterator(nil, nil)
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil, false
+	}
+
+	return it.Key(), true
+}
+
+func (idx *Index) store(store sdk.KVStore) prefix.Store {
+	return prefix.NewStore(store, idx.table.subPrefix(indexesPrefix, idx.id))
+}
+
+// keyStore returns the store of the entries with the given index key, keyed
+// by primary key.
+func (idx *Index) keyStore(store sdk.KVStore, key []byte) prefix.Store {
+	return prefix.NewStore(idx.store(store), indexEntryKey(key, nil))
+}
+
+// indexEntryKey returns the key of an index entry within the store of its
+// index, length-prefixing the index key so that entries of different keys
+// never share a prefix.
+func indexEntryKey(key, pk []byte) []byte {
+	res := make([]byte, 0, 1+len(key)+len(pk))
+	res = append(res, byte(len(key)))
+	res = append(res, key...)
+
+	return append(res, pk...)
+}
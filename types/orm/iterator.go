@@ -0,0 +1,109 @@
+package orm
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Iterator iterates over the rows of a Table.
+type Iterator interface {
+	// LoadNext loads the next row into dest and returns its primary key. It
+	// returns ErrIteratorDone once all rows have been loaded.
+	LoadNext(dest codec.ProtoMarshaler) ([]byte, error)
+
+	// Close releases the iterator.
+	Close() error
+}
+
+// rowIterator iterates over the rows store of a Table.
+type rowIterator struct {
+	table *Table
+	it    sdk.Iterator
+}
+
+func newRowIterator(table *Table, it sdk.Iterator) *rowIterator {
+	return &rowIterator{table: table, it: it}
+}
+
+// LoadNext implements Iterator.
+func (ri *rowIterator) LoadNext(dest codec.ProtoMarshaler) ([]byte, error) {
+	if err := ri.table.assertModel(dest); err != nil {
+		return nil, err
+	}
+
+	if !ri.it.Valid() {
+		return nil, ErrIteratorDone
+	}
+
+	pk := ri.it.Key()
+	if err := ri.table.cdc.UnmarshalBinaryBare(ri.it.Value(), dest); err != nil {
+		return nil, err
+	}
+
+	ri.it.Next()
+
+	return pk, nil
+}
+
+// Close implements Iterator.
+func (ri *rowIterator) Close() error {
+	ri.it.Close()
+	return nil
+}
+
+// indexIterator iterates over the entries of an index key, loading the rows
+// they point to.
+type indexIterator struct {
+	table *Table
+	store sdk.KVStore
+	it    sdk.Iterator
+}
+
+func newIndexIterator(table *Table, store sdk.KVStore, it sdk.Iterator) *indexIterator {
+	return &indexIterator{table: table, store: store, it: it}
+}
+
+// LoadNext implements Iterator.
+func (ii *indexIterator) LoadNext(dest codec.ProtoMarshaler) ([]byte, error) {
+	if !ii.it.Valid() {
+		return nil, ErrIteratorDone
+	}
+
+	pk := ii.it.Key()
+	if err := ii.table.Get(ii.store, pk, dest); err != nil {
+		return nil, err
+	}
+
+	ii.it.Next()
+
+	return pk, nil
+}
+
+// Close implements Iterator.
+func (ii *indexIterator) Close() error {
+	ii.it.Close()
+	return nil
+}
+
+// ReadAll loads all remaining rows of an iterator, allocating each message
+// with newObj, and closes it.
+func ReadAll(it Iterator, newObj func() codec.ProtoMarshaler) ([]codec.ProtoMarshaler, error) {
+	defer it.Close()
+
+	var res []codec.ProtoMarshaler
+
+	for {
+		obj := newObj()
+
+		_, err := it.LoadNext(obj)
+		if ErrIteratorDone.Is(err) {
+			return res, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, obj)
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Package orm provides typed tables of protobuf messages on top of a KVStore.
+
+A Table stores messages of a single type under a prefix, keyed by a primary
+key derived from each message. Secondary indexes, unique or not, are declared
+on the table and kept in sync with its rows on every write, so that keepers
+no longer need to maintain index entries by hand.
+
+The layout of a table with prefix p within its store is:
+
+	p | 0x00 | <primary key>                         -> message
+	p | 0x01                                        -> sequence (auto-increment tables)
+	p | 0x02 | <index id> | len(k) | k | <primary key> -> empty
+
+where k is the key of the row in the index, at most 255 bytes long.
+*/
+package orm
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Key prefixes within the prefix of a table
+const (
+	rowsPrefix     byte = 0x00
+	sequencePrefix byte = 0x01
+	indexesPrefix  byte = 0x02
+)
+
+// PrimaryKeyFunc returns the primary key of a message. It must be non-empty
+// and must not change once the message is stored.
+type PrimaryKeyFunc func(obj codec.ProtoMarshaler) []byte
+
+// Table stores protobuf messages of a single type by primary key and keeps
+// its secondary indexes in sync on write.
+type Table struct {
+	prefix     []byte
+	model      reflect.Type
+	cdc        codec.BinaryMarshaler
+	primaryKey PrimaryKeyFunc
+	indexes    []*Index
+}
+
+// NewTable returns a Table storing messages of the same type as model under
+// the given prefix, keyed by the given primary key function. model must be a
+// pointer to a message.
+func NewTable(prefix []byte, model codec.ProtoMarshaler, cdc codec.BinaryMarshaler, primaryKey PrimaryKeyFunc) *Table {
+	if len(prefix) == 0 {
+		panic("table prefix must not be empty")
+	}
+
+	typ := reflect.TypeOf(model)
+	if typ == nil || typ.Kind() != reflect.Ptr {
+		panic("table model must be a pointer to a message")
+	}
+
+	return &Table{
+		prefix:     prefix,
+		model:      typ,
+		cdc:        cdc,
+		primaryKey: primaryKey,
+	}
+}
+
+// AddIndex declares a non-unique secondary index of the table, identified
+// within the table by the given id. It must be called before the table is
+// used, and the id of an index must not change once rows are stored.
+func (t *Table) AddIndex(id byte, indexKey IndexKeyFunc) *Index {
+	return t.addIndex(id, indexKey, false)
+}
+
+// AddUniqueIndex declares a unique secondary index of the table, identified
+// within the table by the given id. Writes creating a second row with the
+// same index key fail with ErrUniqueConstraint.
+func (t *Table) AddUniqueIndex(id byte, indexKey IndexKeyFunc) *Index {
+	return t.addIndex(id, indexKey, true)
+}
+
+func (t *Table) addIndex(id byte, indexKey IndexKeyFunc, unique bool) *Index {
+	for _, idx := range t.indexes {
+		if idx.id == id {
+			panic("duplicate index id")
+		}
+	}
+
+	idx := &Index{
+		table:    t,
+		id:       id,
+		indexKey: indexKey,
+		unique:   unique,
+	}
+	t.indexes = append(t.indexes, idx)
+
+	return idx
+}
+
+// Create stores a new message. It fails with ErrAlreadyExists if a message
+// with the same primary key is stored, or with ErrUniqueConstraint if it
+// conflicts with another message on a unique index.
+func (t *Table) Create(store sdk.KVStore, obj codec.ProtoMarshaler) error {
+	pk, err := t.rowPrimaryKey(obj)
+	if err != nil {
+		return err
+	}
+
+	if t.Has(store, pk) {
+		return sdkerrors.Wrapf(ErrAlreadyExists, "primary key %X", pk)
+	}
+
+	return t.write(store, pk, nil, obj)
+}
+
+// Update replaces a stored message by one with the same primary key. It fails
+// with ErrNotFound if no such message is stored, or with ErrUniqueConstraint
+// if the new message conflicts with another one on a unique index.
+func (t *Table) Update(store sdk.KVStore, obj codec.ProtoMarshaler) error {
+	pk, err := t.rowPrimaryKey(obj)
+	if err != nil {
+		return err
+	}
+
+	old := t.newModel()
+	if err := t.Get(store, pk, old); err != nil {
+		return err
+	}
+
+	return t.write(store, pk, old, obj)
+}
+
+// Save creates or updates a message.
+func (t *Table) Save(store sdk.KVStore, obj codec.ProtoMarshaler) error {
+	pk, err := t.rowPrimaryKey(obj)
+	if err != nil {
+		return err
+	}
+
+	old := t.newModel()
+	if err := t.Get(store, pk, old); err != nil {
+		if !ErrNotFound.Is(err) {
+			return err
+		}
+
+		old = nil
+	}
+
+	return t.write(store, pk, old, obj)
+}
+
+// Delete removes the message with the given primary key along with its index
+// entries. It fails with ErrNotFound if no such message is stored.
+func (t *Table) Delete(store sdk.KVStore, pk []byte) error {
+	old := t.newModel()
+	if err := t.Get(store, pk, old); err != nil {
+		return err
+	}
+
+	for _, idx := range t.indexes {
+		key, err := idx.rowKey(old)
+		if err != nil {
+			return err
+		}
+
+		if key != nil {
+			idx.store(store).Delete(indexEntryKey(key, pk))
+		}
+	}
+
+	t.rowsStore(store).Delete(pk)
+
+	return nil
+}
+
+// Has returns whether a message with the given primary key is stored.
+func (t *Table) Has(store sdk.KVStore, pk []byte) bool {
+	if len(pk) == 0 {
+		return false
+	}
+
+	return t.rowsStore(store).Has(pk)
+}
+
+// Get loads the message with the given primary key into dest. It fails with
+// ErrNotFound if no such message is stored.
+func (t *Table) Get(store sdk.KVStore, pk []byte, dest codec.ProtoMarshaler) error {
+	if err := t.assertModel(dest); err != nil {
+		return err
+	}
+
+	if len(pk) == 0 {
+		return sdkerrors.Wrap(ErrInvalidKey, "empty primary key")
+	}
+
+	bz := t.rowsStore(store).Get(pk)
+	if bz == nil {
+		return sdkerrors.Wrapf(ErrNotFound, "primary key %X", pk)
+	}
+
+	return t.cdc.UnmarshalBinaryBare(bz, dest)
+}
+
+// Iterator returns an Iterator over the messages with primary keys in
+// [start, end), in ascending order of primary key. Nil bounds are unbounded.
+func (t *Table) Iterator(store sdk.KVStore, start, end []byte) Iterator {
+	return newRowIterator(t, t.rowsStore(store).Iterator(start, end))
+}
+
+// ReverseIterator returns an Iterator over the messages with primary keys in
+// [start, end), in descending order of primary key. Nil bounds are unbounded.
+func (t *Table) ReverseIterator(store sdk.KVStore, start, end []byte) Iterator {
+	return newRowIterator(t, t.rowsStore(store).ReverseIterator(start, end))
+}
+
+// Paginate paginates over the messages of the table, in ascending order of
+// primary key, using query.Paginate. onResult is called with the primary key
+// and a newly allocated message for every row of the page.
+func (t *Table) Paginate(
+	store sdk.KVStore,
+	pageRequest *query.PageRequest,
+	onResult func(pk []byte, obj codec.ProtoMarshaler) error,
+) (*query.PageResponse, error) {
+	return query.Paginate(t.rowsStore(store), pageRequest, func(key []byte, value []byte) error {
+		obj := t.newModel()
+		if err := t.cdc.UnmarshalBinaryBare(value, obj); err != nil {
+			return err
+		}
+
+		return onResult(key, obj)
+	})
+}
+
+// write stores obj under the given primary key, replacing old if non-nil, and
+// updates the index entries of the row.
+func (t *Table) write(store sdk.KVStore, pk []byte, old, obj codec.ProtoMarshaler) error {
+	type indexUpdate struct {
+		idx            *Index
+		oldKey, newKey []byte
+	}
+
+	// compute and check all index keys before writing anything
+	updates := make([]indexUpdate, 0, len(t.indexes))
+
+	for _, idx := range t.indexes {
+		var (
+			u   = indexUpdate{idx: idx}
+			err error
+		)
+
+		if old != nil {
+			if u.oldKey, err = idx.rowKey(old); err != nil {
+				return err
+			}
+		}
+
+		if u.newKey, err = idx.rowKey(obj); err != nil {
+			return err
+		}
+
+		if u.newKey != nil && idx.unique && !bytes.Equal(u.oldKey, u.newKey) {
+			if other, ok := idx.firstPrimaryKey(store, u.newKey); ok && !bytes.Equal(other, pk) {
+				return sdkerrors.Wrapf(ErrUniqueConstraint, "index %d key %X", idx.id, u.newKey)
+			}
+		}
+
+		updates = append(updates, u)
+	}
+
+	bz, err := t.cdc.MarshalBinaryBare(obj)
+	if err != nil {
+		return err
+	}
+
+	t.rowsStore(store).Set(pk, bz)
+
+	for _, u := range updates {
+		if bytes.Equal(u.oldKey, u.newKey) {
+			continue
+		}
+
+		indexStore := u.idx.store(store)
+
+		if u.oldKey != nil {
+			indexStore.Delete(indexEntryKey(u.oldKey, pk))
+		}
+
+		if u.newKey != nil {
+			indexStore.Set(indexEntryKey(u.newKey, pk), []byte{})
+		}
+	}
+
+	return nil
+}
+
+func (t *Table) rowPrimaryKey(obj codec.ProtoMarshaler) ([]byte, error) {
+	if err := t.assertModel(obj); err != nil {
+		return nil, err
+	}
+
+	pk := t.primaryKey(obj)
+	if len(pk) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidKey, "empty primary key")
+	}
+
+	return pk, nil
+}
+
+func (t *Table) assertModel(obj codec.ProtoMarshaler) error {
+	if typ := reflect.TypeOf(obj); typ != t.model {
+		return sdkerrors.Wrapf(ErrInvalidType, "expected %s, got %s", t.model, typ)
+	}
+
+	return nil
+}
+
+func (t *Table) newModel() codec.ProtoMarshaler {
+	return reflect.New(t.model.Elem()).Interface().(codec.ProtoMarshaler)
+}
+
+func (t *Table) rowsStore(store sdk.KVStore) prefix.Store {
+	return prefix.NewStore(store, t.subPrefix(rowsPrefix))
+}
+
+func (t *Table) subPrefix(bz ...byte) []byte {
+	res := make([]byte, 0, len(t.prefix)+len(bz))
+	res = append(res, t.prefix...)
+	return append(res, bz...)
+}
@@ -0,0 +1,204 @@
+package orm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/orm"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+type dogTable struct {
+	*orm.Table
+
+	bySize *orm.Index
+}
+
+// newDogTable returns a table of dogs keyed by name, with a non-unique index
+// by size.
+func newDogTable() dogTable {
+	cdc := codec.NewProtoCodec(testdata.NewTestInterfaceRegistry())
+	table := orm.NewTable([]byte{0x01}, &testdata.Dog{}, cdc, func(obj codec.ProtoMarshaler) []byte {
+		return []byte(obj.(*testdata.Dog).Name)
+	})
+
+	return dogTable{
+		Table: table,
+		bySize: table.AddIndex(0, func(obj codec.ProtoMarshaler) ([]byte, error) {
+			size := obj.(*testdata.Dog).Size_
+			if size == "" {
+				return nil, nil
+			}
+
+			return []byte(size), nil
+		}),
+	}
+}
+
+func newStore() sdk.KVStore {
+	return dbadapter.Store{DB: dbm.NewMemDB()}
+}
+
+func readDogs(t *testing.T, it orm.Iterator) []string {
+	objs, err := orm.ReadAll(it, func() codec.ProtoMarshaler { return &testdata.Dog{} })
+	require.NoError(t, err)
+
+	names := make([]string, len(objs))
+	for i, obj := range objs {
+		names[i] = obj.(*testdata.Dog).Name
+	}
+
+	return names
+}
+
+func TestTableCRUD(t *testing.T) {
+	table, store := newDogTable(), newStore()
+
+	rex := &testdata.Dog{Name: "rex", Size_: "big"}
+	require.NoError(t, table.Create(store, rex))
+	require.True(t, table.Has(store, []byte("rex")))
+	require.True(t, orm.ErrAlreadyExists.Is(table.Create(store, rex)))
+
+	var loaded testdata.Dog
+	require.NoError(t, table.Get(store, []byte("rex"), &loaded))
+	require.Equal(t, *rex, loaded)
+
+	require.True(t, orm.ErrNotFound.Is(table.Get(store, []byte("fido"), &loaded)))
+	require.True(t, orm.ErrNotFound.Is(table.Update(store, &testdata.Dog{Name: "fido"})))
+	require.True(t, orm.ErrInvalidType.Is(table.Create(store, &testdata.Cat{Moniker: "tom"})))
+	require.True(t, orm.ErrInvalidType.Is(table.Get(store, []byte("rex"), &testdata.Cat{})))
+	require.True(t, orm.ErrInvalidKey.Is(table.Create(store, &testdata.Dog{})))
+
+	rex.Size_ = "small"
+	require.NoError(t, table.Update(store, rex))
+	require.NoError(t, table.Get(store, []byte("rex"), &loaded))
+	require.Equal(t, "small", loaded.Size_)
+
+	require.NoError(t, table.Save(store, &testdata.Dog{Name: "fido", Size_: "small"}))
+	require.True(t, table.Has(store, []byte("fido")))
+
+	require.NoError(t, table.Delete(store, []byte("rex")))
+	require.False(t, table.Has(store, []byte("rex")))
+	require.True(t, orm.ErrNotFound.Is(table.Delete(store, []byte("rex"))))
+}
+
+func TestTableIndexSync(t *testing.T) {
+	table, store := newDogTable(), newStore()
+
+	require.NoError(t, table.Create(store, &testdata.Dog{Name: "rex", Size_: "big"}))
+	require.NoError(t, table.Create(store, &testdata.Dog{Name: "fido", Size_: "small"}))
+	require.NoError(t, table.Create(store, &testdata.Dog{Name: "max", Size_: "big"}))
+	require.NoError(t, table.Create(store, &testdata.Dog{Name: "odie"}))
+
+	require.Equal(t, []string{"max", "rex"}, readDogs(t, table.bySize.Iterator(store, []byte("big"))))
+	require.Equal(t, []string{"rex", "max"}, readDogs(t, table.bySize.ReverseIterator(store, []byte("big"))))
+	require.Equal(t, []string{"fido"}, readDogs(t, table.bySize.Iterator(store, []byte("small"))))
+
+	// an index key must not match the entries of a longer key sharing its prefix
+	require.False(t, table.bySize.Has(store, []byte("bi")))
+
+	// updating a row moves its index entry
+	require.NoError(t, table.Update(store, &testdata.Dog{Name: "rex", Size_: "small"}))
+	require.Equal(t, []string{"max"}, readDogs(t, table.bySize.Iterator(store, []byte("big"))))
+	require.Equal(t, []string{"fido", "rex"}, readDogs(t, table.bySize.Iterator(store, []byte("small"))))
+
+	// a nil index key removes the row from the index
+	require.NoError(t, table.Update(store, &testdata.Dog{Name: "max"}))
+	require.False(t, table.bySize.Has(store, []byte("big")))
+
+	// deleting a row removes its index entry
+	require.NoError(t, table.Delete(store, []byte("fido")))
+	require.Equal(t, []string{"rex"}, readDogs(t, table.bySize.Iterator(store, []byte("small"))))
+
+	// the non-unique index does not support Get
+	_, err := table.bySize.Get(store, []byte("small"), &testdata.Dog{})
+	require.Error(t, err)
+}
+
+func TestTableUniqueIndex(t *testing.T) {
+	cdc := codec.NewProtoCodec(testdata.NewTestInterfaceRegistry())
+	table := orm.NewTable([]byte{0x02}, &testdata.Cat{}, cdc, func(obj codec.ProtoMarshaler) []byte {
+		return []byte(obj.(*testdata.Cat).Moniker)
+	})
+	byLives := table.AddUniqueIndex(0, func(obj codec.ProtoMarshaler) ([]byte, error) {
+		return sdk.Uint64ToBigEndian(uint64(obj.(*testdata.Cat).Lives)), nil
+	})
+	store := newStore()
+
+	require.NoError(t, table.Create(store, &testdata.Cat{Moniker: "tom", Lives: 9}))
+	require.True(t, orm.ErrUniqueConstraint.Is(table.Create(store, &testdata.Cat{Moniker: "felix", Lives: 9})))
+	require.False(t, table.Has(store, []byte("felix")))
+
+	require.NoError(t, table.Create(store, &testdata.Cat{Moniker: "felix", Lives: 7}))
+	require.True(t, orm.ErrUniqueConstraint.Is(table.Update(store, &testdata.Cat{Moniker: "felix", Lives: 9})))
+
+	// updating a row without changing its unique key is not a conflict
+	require.NoError(t, table.Update(store, &testdata.Cat{Moniker: "tom", Lives: 9}))
+
+	var cat testdata.Cat
+	pk, err := byLives.Get(store, sdk.Uint64ToBigEndian(7), &cat)
+	require.NoError(t, err)
+	require.Equal(t, []byte("felix"), pk)
+	require.Equal(t, "felix", cat.Moniker)
+
+	// the key is released once the row is deleted
+	require.NoError(t, table.Delete(store, []byte("tom")))
+	_, err = byLives.Get(store, sdk.Uint64ToBigEndian(9), &cat)
+	require.True(t, orm.ErrNotFound.Is(err))
+	require.NoError(t, table.Update(store, &testdata.Cat{Moniker: "felix", Lives: 9}))
+}
+
+func TestTableIterators(t *testing.T) {
+	table, store := newDogTable(), newStore()
+
+	for _, name := range []string{"d", "b", "a", "c"} {
+		require.NoError(t, table.Create(store, &testdata.Dog{Name: name}))
+	}
+
+	require.Equal(t, []string{"a", "b", "c", "d"}, readDogs(t, table.Iterator(store, nil, nil)))
+	require.Equal(t, []string{"b", "c"}, readDogs(t, table.Iterator(store, []byte("b"), []byte("d"))))
+	require.Equal(t, []string{"d", "c", "b", "a"}, readDogs(t, table.ReverseIterator(store, nil, nil)))
+
+	it := table.Iterator(store, nil, nil)
+	defer it.Close()
+
+	_, err := it.LoadNext(&testdata.Cat{})
+	require.True(t, orm.ErrInvalidType.Is(err))
+}
+
+func TestTablePaginate(t *testing.T) {
+	table, store := newDogTable(), newStore()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		require.NoError(t, table.Create(store, &testdata.Dog{Name: name, Size_: "big"}))
+	}
+
+	var names []string
+	onResult := func(pk []byte, obj codec.ProtoMarshaler) error {
+		require.Equal(t, string(pk), obj.(*testdata.Dog).Name)
+		names = append(names, obj.(*testdata.Dog).Name)
+		return nil
+	}
+
+	res, err := table.Paginate(store, &query.PageRequest{Limit: 2, CountTotal: true}, onResult)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, names)
+	require.Equal(t, uint64(5), res.Total)
+
+	names = nil
+	_, err = table.Paginate(store, &query.PageRequest{Key: res.NextKey, Limit: 2}, onResult)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "d"}, names)
+
+	names = nil
+	res, err = table.bySize.Paginate(store, []byte("big"), &query.PageRequest{Offset: 3, CountTotal: true}, onResult)
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "e"}, names)
+	require.Equal(t, uint64(5), res.Total)
+}
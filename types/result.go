@@ -1,18 +1,23 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	yaml "gopkg.in/yaml.v2"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	stypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
 var cdc = codec.New()
@@ -22,6 +27,54 @@ func (gi GasInfo) String() string {
 	return string(bz)
 }
 
+// NewGasBreakdown returns the GasBreakdown recorded by a DetailedGasMeter.
+func NewGasBreakdown(breakdown stypes.GasBreakdown) *GasBreakdown {
+	return &GasBreakdown{
+		Stores:         newGasConsumptions(breakdown.Stores),
+		Operations:     newGasConsumptions(breakdown.Operations),
+		AnteDecorators: newGasConsumptions(breakdown.AnteDecorators),
+		Msgs:           newGasConsumptions(breakdown.Msgs),
+	}
+}
+
+func newGasConsumptions(consumptions []stypes.GasConsumption) []GasConsumption {
+	if len(consumptions) == 0 {
+		return nil
+	}
+
+	res := make([]GasConsumption, len(consumptions))
+	for i, c := range consumptions {
+		res[i] = GasConsumption{Name: c.Name, Gas: c.Gas}
+	}
+
+	return res
+}
+
+func (gc GasConsumption) String() string {
+	return fmt.Sprintf("%s: %d", gc.Name, gc.Gas)
+}
+
+func (gb GasBreakdown) String() string {
+	var sb strings.Builder
+	writeGasConsumptions := func(title string, consumptions []GasConsumption) {
+		if len(consumptions) == 0 {
+			return
+		}
+
+		sb.WriteString(title + ":\n")
+		for _, c := range consumptions {
+			sb.WriteString("  " + c.String() + "\n")
+		}
+	}
+
+	writeGasConsumptions("stores", gb.Stores)
+	writeGasConsumptions("operations", gb.Operations)
+	writeGasConsumptions("ante decorators", gb.AnteDecorators)
+	writeGasConsumptions("msgs", gb.Msgs)
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
 func (r Result) String() string {
 	bz, _ := yaml.Marshal(r)
 	return string(bz)
@@ -65,21 +118,22 @@ func NewResponseResultTx(res *ctypes.ResultTx, tx Tx, timestamp string) *TxRespo
 		return nil
 	}
 
-	parsedLogs, _ := ParseABCILogs(res.TxResult.Log)
+	parsedLogs, _ := ParseMsgLogs(res.TxResult.Data, res.TxResult.Log)
 
 	return &TxResponse{
-		TxHash:    res.Hash.String(),
-		Height:    res.Height,
-		Codespace: res.TxResult.Codespace,
-		Code:      res.TxResult.Code,
-		Data:      strings.ToUpper(hex.EncodeToString(res.TxResult.Data)),
-		RawLog:    res.TxResult.Log,
-		Logs:      parsedLogs,
-		Info:      res.TxResult.Info,
-		GasWanted: res.TxResult.GasWanted,
-		GasUsed:   res.TxResult.GasUsed,
-		Tx:        types.UnsafePackAny(tx),
-		Timestamp: timestamp,
+		TxHash:     res.Hash.String(),
+		Height:     res.Height,
+		Codespace:  res.TxResult.Codespace,
+		Code:       res.TxResult.Code,
+		Data:       strings.ToUpper(hex.EncodeToString(res.TxResult.Data)),
+		RawLog:     res.TxResult.Log,
+		Logs:       parsedLogs,
+		Info:       res.TxResult.Info,
+		GasWanted:  res.TxResult.GasWanted,
+		GasUsed:    res.TxResult.GasUsed,
+		Tx:         types.UnsafePackAny(tx),
+		Timestamp:  timestamp,
+		MsgResults: parseMsgResults(res.TxResult.Data),
 	}
 }
 
@@ -110,16 +164,17 @@ func newTxResponseCheckTx(res *ctypes.ResultBroadcastTxCommit) *TxResponse {
 	parsedLogs, _ := ParseABCILogs(res.CheckTx.Log)
 
 	return &TxResponse{
-		Height:    res.Height,
-		TxHash:    txHash,
-		Codespace: res.CheckTx.Codespace,
-		Code:      res.CheckTx.Code,
-		Data:      strings.ToUpper(hex.EncodeToString(res.CheckTx.Data)),
-		RawLog:    res.CheckTx.Log,
-		Logs:      parsedLogs,
-		Info:      res.CheckTx.Info,
-		GasWanted: res.CheckTx.GasWanted,
-		GasUsed:   res.CheckTx.GasUsed,
+		Height:     res.Height,
+		TxHash:     txHash,
+		Codespace:  res.CheckTx.Codespace,
+		Code:       res.CheckTx.Code,
+		Data:       strings.ToUpper(hex.EncodeToString(res.CheckTx.Data)),
+		RawLog:     res.CheckTx.Log,
+		Logs:       parsedLogs,
+		Info:       res.CheckTx.Info,
+		GasWanted:  res.CheckTx.GasWanted,
+		GasUsed:    res.CheckTx.GasUsed,
+		MsgResults: parseMsgResults(res.CheckTx.Data),
 	}
 }
 
@@ -133,19 +188,20 @@ func newTxResponseDeliverTx(res *ctypes.ResultBroadcastTxCommit) *TxResponse {
 		txHash = res.Hash.String()
 	}
 
-	parsedLogs, _ := ParseABCILogs(res.DeliverTx.Log)
+	parsedLogs, _ := ParseMsgLogs(res.DeliverTx.Data, res.DeliverTx.Log)
 
 	return &TxResponse{
-		Height:    res.Height,
-		TxHash:    txHash,
-		Codespace: res.DeliverTx.Codespace,
-		Code:      res.DeliverTx.Code,
-		Data:      strings.ToUpper(hex.EncodeToString(res.DeliverTx.Data)),
-		RawLog:    res.DeliverTx.Log,
-		Logs:      parsedLogs,
-		Info:      res.DeliverTx.Info,
-		GasWanted: res.DeliverTx.GasWanted,
-		GasUsed:   res.DeliverTx.GasUsed,
+		Height:     res.Height,
+		TxHash:     txHash,
+		Codespace:  res.DeliverTx.Codespace,
+		Code:       res.DeliverTx.Code,
+		Data:       strings.ToUpper(hex.EncodeToString(res.DeliverTx.Data)),
+		RawLog:     res.DeliverTx.Log,
+		Logs:       parsedLogs,
+		Info:       res.DeliverTx.Info,
+		GasWanted:  res.DeliverTx.GasWanted,
+		GasUsed:    res.DeliverTx.GasUsed,
+		MsgResults: parseMsgResults(res.DeliverTx.Data),
 	}
 }
 
@@ -241,6 +297,66 @@ func ParseABCILogs(logs string) (res ABCIMessageLogs, err error) {
 	return res, err
 }
 
+// ParseMsgLogs returns the log of each message of a tx, decoded from the
+// MsgResults of the TxData of its ABCI result. The events of each message log
+// have a msg_index attribute holding the index of the message. If the data
+// holds no message result, e.g. if the tx failed, the logs are parsed from the
+// stringified ABCI tx log instead.
+func ParseMsgLogs(data []byte, logs string) (ABCIMessageLogs, error) {
+	msgResults := parseMsgResults(data)
+	if len(msgResults) == 0 {
+		return ParseABCILogs(logs)
+	}
+
+	res := make(ABCIMessageLogs, len(msgResults))
+	for i, msgResult := range msgResults {
+		res[i] = NewABCIMessageLog(uint32(i), msgResult.Log, msgResult.GetEvents())
+	}
+
+	return res, nil
+}
+
+// ParseTxData parses the data of an ABCI tx result into the TxData holding the
+// data and the Result of each message of the tx.
+func ParseTxData(data []byte) (*TxData, error) {
+	var txData TxData
+	if err := proto.Unmarshal(data, &txData); err != nil {
+		return nil, err
+	}
+
+	return &txData, nil
+}
+
+// MsgEvents returns the events of the message at the given index from a
+// flattened slice of events, i.e. the ones with a msg_index attribute holding
+// the index.
+func MsgEvents(events []abci.Event, msgIndex int) Events {
+	index := []byte(strconv.Itoa(msgIndex))
+
+	res := EmptyEvents()
+	for _, e := range events {
+		for _, attr := range e.Attributes {
+			if string(attr.Key) == AttributeKeyMsgIndex && bytes.Equal(attr.Value, index) {
+				res = append(res, Event(e))
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+// parseMsgResults returns the message results of the data of an ABCI tx result,
+// or nil if the data cannot be parsed, e.g. if the tx failed.
+func parseMsgResults(data []byte) []Result {
+	txData, err := ParseTxData(data)
+	if err != nil {
+		return nil
+	}
+
+	return txData.MsgResults
+}
+
 var _, _ types.UnpackInterfacesMessage = SearchTxsResult{}, TxResponse{}
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
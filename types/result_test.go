@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/bytes"
@@ -26,6 +27,52 @@ func TestParseABCILog(t *testing.T) {
 	require.Equal(t, res[0].MsgIndex, uint32(1))
 }
 
+func TestParseTxData(t *testing.T) {
+	t.Parallel()
+	events := sdk.Events{
+		sdk.NewEvent("transfer", sdk.NewAttribute("sender", "foo"), sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0")),
+		sdk.NewEvent("transfer", sdk.NewAttribute("sender", "bar"), sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "1")),
+	}
+	txData := &sdk.TxData{
+		Data: []*sdk.MsgData{{MsgType: "send", Data: []byte("foo")}, {MsgType: "send", Data: []byte("bar")}},
+		MsgResults: []sdk.Result{
+			{Data: []byte("foo"), Events: events[:1].ToABCIEvents()},
+			{Data: []byte("bar"), Events: events[1:].ToABCIEvents()},
+		},
+	}
+	bz, err := proto.Marshal(txData)
+	require.NoError(t, err)
+
+	res, err := sdk.ParseTxData(bz)
+	require.NoError(t, err)
+	require.Equal(t, txData, res)
+
+	_, err = sdk.ParseTxData([]byte("data"))
+	require.Error(t, err)
+
+	resultTx := &ctypes.ResultTx{TxResult: abci.ResponseDeliverTx{Data: bz, Log: `[]`, Events: events.ToABCIEvents()}}
+	require.Equal(t, txData.MsgResults, sdk.NewResponseResultTx(resultTx, sdk.Tx(nil), "").MsgResults)
+
+	msgLogs, err := sdk.ParseMsgLogs(bz, "")
+	require.NoError(t, err)
+	require.Equal(t, sdk.ABCIMessageLogs{
+		sdk.NewABCIMessageLog(0, "", events[:1]),
+		sdk.NewABCIMessageLog(1, "", events[1:]),
+	}, msgLogs)
+	require.Equal(t, msgLogs, sdk.NewResponseResultTx(resultTx, sdk.Tx(nil), "").Logs)
+
+	// the logs of a tx without message results are parsed from its log
+	msgLogs, err = sdk.ParseMsgLogs(nil, `[{"log":"foo","msg_index":1}]`)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ABCIMessageLogs{{MsgIndex: 1, Log: "foo"}}, msgLogs)
+
+	_, err = sdk.ParseMsgLogs(nil, "failed")
+	require.Error(t, err)
+
+	require.Equal(t, events[1:], sdk.MsgEvents(resultTx.TxResult.Events, 1))
+	require.Empty(t, sdk.MsgEvents(resultTx.TxResult.Events, 2))
+}
+
 func TestABCIMessageLog(t *testing.T) {
 	t.Parallel()
 	cdc := codec.New()
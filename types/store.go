@@ -150,6 +150,14 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+// Well-known keys of a TraceContext.
+const (
+	TraceContextKeyStore       = types.TraceContextKeyStore
+	TraceContextKeyBlockHeight = types.TraceContextKeyBlockHeight
+	TraceContextKeyTxHash      = types.TraceContextKeyTxHash
+	TraceContextKeyMsgIndex    = types.TraceContextKeyMsgIndex
+)
+
 // --------------------------------------
 
 type (
@@ -170,3 +178,19 @@ type (
 func NewInfiniteGasMeter() GasMeter {
 	return types.NewInfiniteGasMeter()
 }
+
+type (
+	DetailedGasMeter = types.DetailedGasMeter
+	GasScope         = types.GasScope
+	GasScopeKind     = types.GasScopeKind
+)
+
+const (
+	GasScopeNone          = types.GasScopeNone
+	GasScopeAnteDecorator = types.GasScopeAnteDecorator
+	GasScopeMsg           = types.GasScopeMsg
+)
+
+func NewDetailedGasMeter(meter GasMeter) DetailedGasMeter {
+	return types.NewDetailedGasMeter(meter)
+}
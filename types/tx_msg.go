@@ -77,4 +77,9 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
 
+// MsgTypeURL returns the type URL of a Msg, as found in the Any it is packed in.
+func MsgTypeURL(msg Msg) string {
+	return "/" + proto.MessageName(msg)
+}
+
 //__________________________________________________________
@@ -23,3 +23,9 @@ func TestTestMsg(t *testing.T) {
 	require.NotPanics(t, func() { msg.GetSignBytes() })
 	require.Equal(t, []sdk.AccAddress{accAddr}, msg.GetSigners())
 }
+
+func TestMsgTypeURL(t *testing.T) {
+	t.Parallel()
+	msg := testdata.NewTestMsg(sdk.AccAddress([]byte{0, 1, 2, 3}))
+	require.Equal(t, "/testdata.TestMsg", sdk.MsgTypeURL(msg))
+}
@@ -0,0 +1,62 @@
+package txtrace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ParseTraceOperations parses the operations of a store trace, written as
+// lines of JSON by the tracing KVStore, into TraceOperations.
+func ParseTraceOperations(trace []byte) ([]TraceOperation, error) {
+	var ops []TraceOperation
+
+	scanner := bufio.NewScanner(bytes.NewReader(trace))
+	scanner.Buffer(nil, len(trace)+1)
+
+	for scanner.Scan() {
+		var op tracekv.TraceOperation
+		if err := json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			return nil, fmt.Errorf("failed to parse trace operation %d: %w", len(ops), err)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key of trace operation %d: %w", len(ops), err)
+		}
+
+		value, err := base64.StdEncoding.DecodeString(op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode value of trace operation %d: %w", len(ops), err)
+		}
+
+		msgIndex := int64(-1)
+		if op.MsgIndex != nil {
+			msgIndex = int64(*op.MsgIndex)
+		}
+
+		ops = append(ops, TraceOperation{
+			Operation: string(op.Operation),
+			Store:     op.Store,
+			Key:       key,
+			Value:     value,
+			MsgIndex:  msgIndex,
+		})
+	}
+
+	return ops, scanner.Err()
+}
+
+// Err returns the error the traced tx failed with, or nil if it succeeded.
+func (r QueryTraceTxResponse) Err() error {
+	if r.Code == sdkerrors.SuccessABCICode {
+		return nil
+	}
+
+	return sdkerrors.ABCIError(r.Codespace, r.Code, r.Log)
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// EmitTypedEvent converts a typed protobuf event into an Event and stores it.
+func (em *EventManager) EmitTypedEvent(tev proto.Message) error {
+	event, err := TypedEventToEvent(tev)
+	if err != nil {
+		return err
+	}
+
+	em.EmitEvent(event)
+	return nil
+}
+
+// EmitTypedEvents converts a series of typed protobuf events into Events and
+// stores them. No event is stored if any of them fails to convert.
+func (em *EventManager) EmitTypedEvents(tevs ...proto.Message) error {
+	events := make(Events, len(tevs))
+	for i, tev := range tevs {
+		event, err := TypedEventToEvent(tev)
+		if err != nil {
+			return err
+		}
+
+		events[i] = event
+	}
+
+	em.EmitEvents(events)
+	return nil
+}
+
+// TypedEventToEvent converts a typed protobuf event into an Event. The type of
+// the Event is the fully-qualified name of the protobuf message, i.e. its type
+// URL without the leading slash, and it has an attribute for each non-default
+// field of the message, keyed by the field name and holding its JSON encoded
+// value. The attributes are sorted by key.
+func TypedEventToEvent(tev proto.Message) (Event, error) {
+	evtType := proto.MessageName(tev)
+	if evtType == "" {
+		return Event{}, fmt.Errorf("typed event %T is not a registered protobuf message", tev)
+	}
+
+	evtJSON, err := codec.ProtoMarshalJSON(tev)
+	if err != nil {
+		return Event{}, err
+	}
+
+	var attrMap map[string]json.RawMessage
+	if err := json.Unmarshal(evtJSON, &attrMap); err != nil {
+		return Event{}, err
+	}
+
+	keys := make([]string, 0, len(attrMap))
+	for k := range attrMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]tmkv.Pair, len(keys))
+	for i, k := range keys {
+		attrs[i] = tmkv.Pair{Key: []byte(k), Value: attrMap[k]}
+	}
+
+	return Event{Type: evtType, Attributes: attrs}, nil
+}
+
+// ParseTypedEvent reconstructs the typed protobuf event from an Event emitted
+// by EmitTypedEvent. The protobuf message of the event type must be registered.
+// The attributes which are not fields of the message are skipped, e.g. the
+// msg_index attribute appended by BaseApp to the events of a message.
+func ParseTypedEvent(event abci.Event) (proto.Message, error) {
+	concreteGoType := proto.MessageType(event.Type)
+	if concreteGoType == nil {
+		return nil, fmt.Errorf("failed to retrieve the message of type %q", event.Type)
+	}
+
+	var value reflect.Value
+	if concreteGoType.Kind() == reflect.Ptr {
+		value = reflect.New(concreteGoType.Elem())
+	} else {
+		value = reflect.Zero(concreteGoType)
+	}
+
+	protoMsg, ok := value.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%q does not implement proto.Message", event.Type)
+	}
+
+	fields := typedEventFields(concreteGoType)
+
+	attrMap := make(map[string]json.RawMessage, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		if fields != nil && !fields[string(attr.Key)] {
+			continue
+		}
+
+		attrMap[string(attr.Key)] = attr.Value
+	}
+
+	attrBytes, err := json.Marshal(attrMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jsonpb.Unmarshal(bytes.NewReader(attrBytes), protoMsg); err != nil {
+		return nil, err
+	}
+
+	return protoMsg, nil
+}
+
+// typedEventFields returns the names of the fields of a protobuf message type,
+// both original and JSON, as accepted by jsonpb, or nil if the type is not a
+// pointer to a struct.
+func typedEventFields(typ reflect.Type) map[string]bool {
+	if typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	props := proto.GetProperties(typ.Elem())
+
+	fields := make(map[string]bool)
+	addField := func(prop *proto.Properties) {
+		for _, name := range []string{prop.OrigName, prop.JSONName} {
+			if name != "" {
+				fields[name] = true
+			}
+		}
+	}
+
+	for _, prop := range props.Prop {
+		addField(prop)
+	}
+	for _, oneof := range props.OneofTypes {
+		addField(oneof.Prop)
+	}
+
+	return fields
+}
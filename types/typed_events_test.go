@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEmitTypedEvent(t *testing.T) {
+	em := sdk.NewEventManager()
+	dog := &testdata.Dog{Name: "Spot", Size_: "big"}
+	cat := &testdata.Cat{Moniker: "Garfield", Lives: 6}
+
+	require.NoError(t, em.EmitTypedEvent(dog))
+	require.NoError(t, em.EmitTypedEvents(cat))
+
+	// the attributes are the sorted JSON encoded fields of the event
+	events := em.ABCIEvents()
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent("testdata.Dog", sdk.NewAttribute("name", `"Spot"`), sdk.NewAttribute("size", `"big"`)),
+		sdk.NewEvent("testdata.Cat", sdk.NewAttribute("lives", "6"), sdk.NewAttribute("moniker", `"Garfield"`)),
+	}.ToABCIEvents(), events)
+
+	parsed, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	require.Equal(t, dog, parsed)
+
+	parsed, err = sdk.ParseTypedEvent(events[1])
+	require.NoError(t, err)
+	require.Equal(t, cat, parsed)
+
+	// default values are omitted and parsed back
+	require.NoError(t, em.EmitTypedEvent(&testdata.Cat{}))
+	event := em.ABCIEvents()[2]
+	require.Empty(t, event.Attributes)
+
+	parsed, err = sdk.ParseTypedEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, &testdata.Cat{}, parsed)
+}
+
+func TestParseTypedEventErrors(t *testing.T) {
+	_, err := sdk.ParseTypedEvent(abci.Event{Type: "transfer"})
+	require.Error(t, err)
+
+	event, err := sdk.TypedEventToEvent(&testdata.Cat{Lives: 9})
+	require.NoError(t, err)
+
+	// the attributes which are not fields are skipped, but not the invalid ones
+	parsed, err := sdk.ParseTypedEvent(abci.Event(event.AppendAttributes(sdk.NewAttribute("unknown", "foo"))))
+	require.NoError(t, err)
+	require.Equal(t, &testdata.Cat{Lives: 9}, parsed)
+
+	event = event.AppendAttributes(sdk.NewAttribute("moniker", "foo"))
+	_, err = sdk.ParseTypedEvent(abci.Event(event))
+	require.Error(t, err)
+}
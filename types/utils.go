@@ -86,12 +86,22 @@ func ParseTimeBytes(bz []byte) (time.Time, error) {
 
 // NewLevelDB instantiate a new LevelDB instance according to DBBackend.
 func NewLevelDB(name, dir string) (db dbm.DB, err error) {
+	return NewDB(name, backend, dir)
+}
+
+// NewDB instantiates a new database of the given backend, or of the one set by
+// DBBackend if empty.
+func NewDB(name string, backendType dbm.BackendType, dir string) (db dbm.DB, err error) {
+	if backendType == "" {
+		backendType = backend
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("couldn't create db: %v", r)
 		}
 	}()
-	return dbm.NewDB(name, backend, dir), err
+	return dbm.NewDB(name, backendType, dir), err
 }
 
 // copy bytes
@@ -28,3 +28,11 @@ func NewAnteHandler(
 		NewIncrementSequenceDecorator(ak),
 	)
 }
+
+// NewPostHandler returns a PostHandler that refunds the fee paid for the unused
+// gas of a tx to its fee payer, as set by the GasRefundRatio parameter.
+func NewPostHandler(ak AccountKeeper, bankKeeper types.BankKeeper) sdk.PostHandler {
+	return sdk.ChainPostDecorators(
+		NewRefundGasDecorator(ak, bankKeeper),
+	)
+}
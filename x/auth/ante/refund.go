@@ -0,0 +1,80 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RefundGasDecorator refunds to the fee payer of a tx the fraction, set by the
+// GasRefundRatio parameter, of the fee paid for the gas wanted but not used by
+// the tx, at the gas price implied by its fee and gas limit. The refund is sent
+// from the fee collector, and does not consume the gas of the tx.
+// CONTRACT: Tx must implement FeeTx interface to use RefundGasDecorator, and
+// the fees must have been deducted by the DeductFeeDecorator.
+type RefundGasDecorator struct {
+	ak         AccountKeeper
+	bankKeeper types.BankKeeper
+}
+
+func NewRefundGasDecorator(ak AccountKeeper, bk types.BankKeeper) RefundGasDecorator {
+	return RefundGasDecorator{
+		ak:         ak,
+		bankKeeper: bk,
+	}
+}
+
+func (rgd RefundGasDecorator) PostHandle(
+	ctx sdk.Context, tx sdk.Tx, result *sdk.Result, simulate bool, next sdk.PostHandler,
+) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the gas used is measured before refunding, which consumes no gas
+	gasUsed := ctx.GasMeter().GasConsumed()
+	refundCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	ratio := rgd.ak.GetParams(refundCtx).GasRefundRatio
+	refund := GasRefund(feeTx.GetFee(), feeTx.GetGas(), gasUsed, ratio)
+
+	if !refund.IsZero() {
+		feePayer := feeTx.FeePayer()
+
+		err := rgd.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, types.FeeCollectorName, feePayer, refund)
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "failed to refund unused gas to %s", feePayer)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeGasRefund,
+				sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+			),
+		)
+	}
+
+	return next(ctx, tx, result, simulate)
+}
+
+// GasRefund returns the fraction ratio of the fee paid for the gas wanted but
+// not used, i.e. fee * (gasWanted - gasUsed) / gasWanted * ratio, rounded down.
+func GasRefund(fee sdk.Coins, gasWanted, gasUsed uint64, ratio sdk.Dec) sdk.Coins {
+	if fee.IsZero() || ratio.IsNil() || !ratio.IsPositive() || gasUsed >= gasWanted {
+		return sdk.NewCoins()
+	}
+
+	unused := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasWanted - gasUsed)).
+		QuoInt(sdk.NewIntFromUint64(gasWanted)).
+		Mul(ratio)
+
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := coin.Amount.ToDec().Mul(unused).TruncateInt()
+		refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return refund
+}
@@ -0,0 +1,98 @@
+package ante_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *AnteTestSuite) TestGasRefund() {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 3))
+
+	testCases := []struct {
+		name      string
+		gasWanted uint64
+		gasUsed   uint64
+		ratio     sdk.Dec
+		expected  sdk.Coins
+	}{
+		{"disabled", 100000, 40000, sdk.ZeroDec(), sdk.NewCoins()},
+		{"all gas used", 100000, 100000, sdk.OneDec(), sdk.NewCoins()},
+		{"full refund", 100000, 40000, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 90), sdk.NewInt64Coin("stake", 1))},
+		{"half refund", 100000, 40000, sdk.NewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("atom", 45))},
+	}
+
+	for _, tc := range testCases {
+		refund := ante.GasRefund(fee, tc.gasWanted, tc.gasUsed, tc.ratio)
+		suite.Require().Equal(tc.expected, refund, tc.name)
+	}
+}
+
+func (suite *AnteTestSuite) TestRefundGasDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.app.BankKeeper.SetBalances(suite.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)))
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper))
+	posthandler := ante.NewPostHandler(suite.app.AccountKeeper, suite.app.BankKeeper)
+
+	deliverTx := func() sdk.Context {
+		ctx := suite.ctx.WithGasMeter(sdk.NewGasMeter(testdata.NewTestGasLimit())).WithEventManager(sdk.NewEventManager())
+		ctx, err := antehandler(ctx, tx, false)
+		suite.Require().NoError(err)
+
+		// as in runTx, the post handler gets its own event manager
+		ctx.GasMeter().ConsumeGas(60000-ctx.GasMeter().GasConsumed(), "test")
+		ctx, err = posthandler(ctx.WithEventManager(sdk.NewEventManager()), tx, &sdk.Result{}, false)
+		suite.Require().NoError(err)
+
+		// the refund does not consume gas
+		suite.Require().Equal(uint64(60000), ctx.GasMeter().GasConsumed())
+
+		return ctx
+	}
+
+	// refunds are disabled by default
+	ctx := deliverTx()
+	suite.Require().Equal(sdk.NewInt(50), suite.app.BankKeeper.GetBalance(ctx, addr1, "atom").Amount)
+	suite.Require().Empty(ctx.EventManager().Events())
+
+	// refund half of the fee paid for the unused 40% of the gas limit
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.GasRefundRatio = sdk.NewDecWithPrec(5, 1)
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+	suite.app.BankKeeper.SetBalances(suite.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)))
+
+	ctx = deliverTx()
+	suite.Require().Equal(sdk.NewInt(80), suite.app.BankKeeper.GetBalance(ctx, addr1, "atom").Amount)
+
+	// the refund transfer events, legacy and typed, are followed by the refund
+	// event
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 4)
+	suite.Require().Equal(banktypes.EventTypeTransfer, events[0].Type)
+	suite.Require().Equal(sdk.EventTypeMessage, events[1].Type)
+	suite.Require().Equal(proto.MessageName(&banktypes.EventTransfer{}), events[2].Type)
+	suite.Require().Equal(types.EventTypeGasRefund, events[3].Type)
+}
@@ -65,12 +65,22 @@ func (sud SetUpContextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 }
 
 // SetGasMeter returns a new context with a gas meter set from a given context.
+// If the given context has a detailed gas meter, the new gas meter keeps
+// recording into its breakdown.
 func SetGasMeter(simulate bool, ctx sdk.Context, gasLimit uint64) sdk.Context {
+	var gasMeter sdk.GasMeter
+
 	// In various cases such as simulation and during the genesis block, we do not
 	// meter any gas utilization.
 	if simulate || ctx.BlockHeight() == 0 {
-		return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		gasMeter = sdk.NewInfiniteGasMeter()
+	} else {
+		gasMeter = sdk.NewGasMeter(gasLimit)
+	}
+
+	if dgm, ok := ctx.GasMeter().(sdk.DetailedGasMeter); ok {
+		gasMeter = dgm.Wrap(gasMeter)
 	}
 
-	return ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	return ctx.WithGasMeter(gasMeter)
 }
@@ -99,3 +99,19 @@ type PanicDecorator struct{}
 func (pd PanicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	panic("random error")
 }
+
+func (suite *AnteTestSuite) TestSetGasMeterDetailed() {
+	suite.SetupTest(true) // setup
+
+	meter := sdk.NewDetailedGasMeter(sdk.NewInfiniteGasMeter())
+	ctx := suite.ctx.WithBlockHeight(1).WithGasMeter(meter)
+
+	newCtx := ante.SetGasMeter(false, ctx, 100)
+	dgm, ok := newCtx.GasMeter().(sdk.DetailedGasMeter)
+	suite.Require().True(ok, "GasMeter is not detailed anymore")
+	suite.Require().Equal(uint64(100), dgm.Limit())
+
+	dgm.ConsumeGas(10, "test")
+	suite.Require().Equal(uint64(0), meter.GasConsumed())
+	suite.Require().Equal(uint64(10), meter.GasBreakdown().Operations[0].Gas)
+}
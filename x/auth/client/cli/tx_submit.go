@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// GetSubmitCommand returns the tx submit command.
+func GetSubmitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [file_path]",
+		Short: "Build, sign and broadcast a transaction from messages defined in a file",
+		Long: strings.TrimSpace(`Build a transaction from the messages defined in [file_path], then sign
+and broadcast it, or print it when --generate-only is set. This allows sending
+any message registered by the application, including messages that have no
+dedicated command.
+
+The file must contain either a list of messages or an object with a "messages"
+list, in JSON or YAML. Each message is encoded in protobuf JSON form with its
+type URL in an "@type" field. If you supply a dash (-) argument in place of an
+input filename, the command reads from standard input.
+
+Example:
+
+[
+  {
+    "@type": "/cosmos.bank.MsgMultiSend",
+    "inputs": [{"address": "cosmos1...", "coins": [{"denom": "stake", "amount": "20"}]}],
+    "outputs": [
+      {"address": "cosmos1...", "coins": [{"denom": "stake", "amount": "10"}]},
+      {"address": "cosmos1...", "coins": [{"denom": "stake", "amount": "10"}]}
+    ]
+  }
+]
+
+$ <appcli> tx submit ./msgs.json --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msgs, err := authclient.ReadMsgsFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,8 @@ package client
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -10,10 +12,12 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"gopkg.in/yaml.v2"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -137,6 +141,136 @@ func ReadTxFromFile(ctx client.Context, filename string) (tx sdk.Tx, err error)
 	return ctx.TxConfig.TxJSONDecoder()(bytes)
 }
 
+// ReadMsgsFromFile reads a list of messages encoded as protobuf JSON Any's
+// (i.e. objects carrying their type URL in an "@type" field) from the given
+// filename, or from stdin if filename is "-". The file may contain either a
+// list of messages or an object with a "messages" list, in JSON or YAML. Each
+// message is resolved against the context's InterfaceRegistry and checked with
+// ValidateBasic.
+func ReadMsgsFromFile(clientCtx client.Context, filename string) ([]sdk.Msg, error) {
+	var (
+		bz  []byte
+		err error
+	)
+
+	if filename == "-" {
+		bz, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		bz, err = ioutil.ReadFile(filename)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseMsgs(clientCtx, bz)
+}
+
+// ParseMsgs decodes a list of messages in the format accepted by
+// ReadMsgsFromFile.
+func ParseMsgs(clientCtx client.Context, bz []byte) ([]sdk.Msg, error) {
+	if clientCtx.InterfaceRegistry == nil {
+		return nil, errors.New("an interface registry is required to resolve messages")
+	}
+
+	if !json.Valid(bz) {
+		var doc interface{}
+		if err := yaml.Unmarshal(bz, &doc); err != nil {
+			return nil, fmt.Errorf("messages must be encoded in JSON or YAML: %w", err)
+		}
+
+		doc, err := yamlToJSONValue(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		if bz, err = json.Marshal(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(bz, &rawMsgs); err != nil {
+		var body struct {
+			Messages []json.RawMessage `json:"messages"`
+		}
+
+		if err := json.Unmarshal(bz, &body); err != nil {
+			return nil, errors.New("expected a list of messages or an object with a messages list")
+		}
+
+		rawMsgs = body.Messages
+	}
+
+	if len(rawMsgs) == 0 {
+		return nil, errors.New("no messages provided")
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		var any codectypes.Any
+		if err := jsonpb.Unmarshal(bytes.NewReader(rawMsg), &any); err != nil {
+			return nil, fmt.Errorf("failed to decode message %d: %w", i, err)
+		}
+
+		var msg sdk.Msg
+		if err := clientCtx.InterfaceRegistry.UnpackAny(&any, &msg); err != nil {
+			return nil, fmt.Errorf("failed to resolve message %d: %w", i, err)
+		}
+
+		if msg == nil {
+			return nil, fmt.Errorf("message %d has no type URL", i)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// yamlToJSONValue converts a value decoded by yaml.v2, whose maps are keyed by
+// interface{}, into one that can be JSON encoded.
+func yamlToJSONValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			strKey, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported non-string key %v", key)
+			}
+
+			converted, err := yamlToJSONValue(val)
+			if err != nil {
+				return nil, err
+			}
+
+			m[strKey] = converted
+		}
+
+		return m, nil
+
+	case []interface{}:
+		for i, val := range v {
+			converted, err := yamlToJSONValue(val)
+			if err != nil {
+				return nil, err
+			}
+
+			v[i] = converted
+		}
+
+		return v, nil
+
+	default:
+		return v, nil
+	}
+}
+
 // NewBatchScanner returns a new BatchScanner to read newline-delimited StdTx transactions from r.
 func NewBatchScanner(cfg client.TxConfig, r io.Reader) *BatchScanner {
 	return &BatchScanner{Scanner: bufio.NewScanner(r), cfg: cfg}
@@ -18,9 +18,11 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var (
@@ -219,3 +221,60 @@ func makeCodec() *codec.Codec {
 	cdc.RegisterConcrete(testdata.TestMsg{}, "cosmos-sdk/Test", nil)
 	return cdc
 }
+
+func TestParseMsgs(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	clientCtx := client.Context{}.WithInterfaceRegistry(registry)
+
+	from, to := sdk.AccAddress("from________________"), sdk.AccAddress("to__________________")
+	msgJSON := `{"@type":"/cosmos.bank.MsgSend","from_address":"` + from.String() +
+		`","to_address":"` + to.String() + `","amount":[{"denom":"stake","amount":"10"}]}`
+	expected := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	msgYAML := `
+messages:
+- "@type": /cosmos.bank.MsgSend
+  from_address: ` + from.String() + `
+  to_address: ` + to.String() + `
+  amount:
+  - denom: stake
+    amount: "10"
+`
+
+	testCases := []struct {
+		name   string
+		input  string
+		expErr bool
+	}{
+		{"json list", "[" + msgJSON + "," + msgJSON + "]", false},
+		{"json object", `{"messages":[` + msgJSON + `]}`, false},
+		{"yaml object", msgYAML, false},
+		{"empty list", "[]", true},
+		{"unknown type", `[{"@type":"/cosmos.bank.Unknown"}]`, true},
+		{"unregistered type", `[{"@type":"/cosmos.auth.Params"}]`, true},
+		{"invalid message", `[{"@type":"/cosmos.bank.MsgSend"}]`, true},
+		{"missing type", `[{"from_address":"` + from.String() + `"}]`, true},
+		{"not a list", `"foo"`, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := ParseMsgs(clientCtx, []byte(tc.input))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, msgs)
+			for _, msg := range msgs {
+				require.Equal(t, expected, msg)
+			}
+		})
+	}
+
+	_, err := ParseMsgs(client.Context{}, []byte("["+msgJSON+"]"))
+	require.Error(t, err)
+}
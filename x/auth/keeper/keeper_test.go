@@ -8,9 +8,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
@@ -116,6 +118,17 @@ func TestGetSetParams(t *testing.T) {
 
 	actualParams := app.AccountKeeper.GetParams(ctx)
 	require.Equal(t, params, actualParams)
+
+	// the gas refund ratio defaults on chains started before it was introduced
+	params.GasRefundRatio = sdk.NewDecWithPrec(5, 1)
+	app.AccountKeeper.SetParams(ctx, params)
+
+	subspace := app.GetSubspace(types.ModuleName)
+	prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(subspace.Name()+"/")).
+		Delete(types.KeyGasRefundRatio)
+
+	params.GasRefundRatio = types.DefaultGasRefundRatio
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
 }
 
 func TestSupply_ValidatePermissions(t *testing.T) {
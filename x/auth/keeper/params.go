@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -10,8 +12,18 @@ func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	ak.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. The gas refund ratio takes its
+// default value, which disables refunds, if it was never set, as on chains
+// started before it was introduced.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyGasRefundRatio) && !ak.paramSubspace.Has(ctx, pair.Key) {
+			params.GasRefundRatio = types.DefaultGasRefundRatio
+			continue
+		}
+
+		ak.paramSubspace.Get(ctx, pair.Key, pair.Value)
+	}
+
 	return
 }
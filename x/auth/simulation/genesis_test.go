@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/simulation"
@@ -45,6 +46,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, uint64(0x1ff), authGenesis.Params.GetSigVerifyCostSecp256k1())
 	require.Equal(t, uint64(9), authGenesis.Params.GetTxSigLimit())
 	require.Equal(t, uint64(5), authGenesis.Params.GetTxSizeCostPerByte())
+	require.Equal(t, sdk.NewDecWithPrec(38, 2), authGenesis.Params.GasRefundRatio)
 
 	genAccounts, err := types.UnpackAccounts(authGenesis.Accounts)
 	require.NoError(t, err)
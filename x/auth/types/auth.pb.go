@@ -113,6 +113,9 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	// gas_refund_ratio is the fraction of the fee paid for the gas wanted but not
+	// used by a tx which is refunded to its fee payer. Zero disables refunds.
+	GasRefundRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=gas_refund_ratio,json=gasRefundRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_refund_ratio" yaml:"gas_refund_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -191,48 +194,51 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/auth.proto", fileDescriptor_ec2401f40a84da7e) }
 
 var fileDescriptor_ec2401f40a84da7e = []byte{
-	// 650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x49, 0x7e, 0xfc, 0xd9, 0x00, 0x12, 0x26, 0x80, 0xc9, 0xaf, 0xf2, 0x46, 0x3e, 0x51,
-	0xa9, 0x09, 0x82, 0x8a, 0x4a, 0xe4, 0x50, 0x15, 0xd3, 0x56, 0x42, 0x14, 0x84, 0x16, 0xa9, 0xaa,
-	0x7a, 0xb1, 0xd6, 0xce, 0x36, 0x58, 0x64, 0xb3, 0xc6, 0xbb, 0xae, 0x62, 0x3e, 0x41, 0x8f, 0x3d,
-	0x55, 0x3d, 0xf2, 0x21, 0x7a, 0xeb, 0x17, 0xe8, 0x11, 0xf5, 0xd4, 0x93, 0x5b, 0x85, 0x4b, 0xd5,
-	0xa3, 0x8f, 0x3d, 0x55, 0xde, 0x35, 0xc1, 0x41, 0xb4, 0x97, 0x64, 0xe7, 0xcd, 0xbc, 0x37, 0xe3,
-	0x37, 0xda, 0x05, 0xcb, 0x1e, 0xe3, 0x94, 0xf1, 0x75, 0x1c, 0x89, 0x13, 0xf9, 0xd3, 0x0a, 0x42,
-	0x26, 0x98, 0x5e, 0x55, 0x78, 0x2b, 0x83, 0xea, 0xab, 0x2a, 0x70, 0x64, 0x6a, 0x3d, 0xcf, 0xc8,
-	0xa0, 0x5e, 0xeb, 0xb2, 0x2e, 0x53, 0x78, 0x76, 0x52, 0xa8, 0xf5, 0x61, 0x02, 0x54, 0x6d, 0xcc,
-	0xc9, 0x8e, 0xe7, 0xb1, 0xa8, 0x2f, 0xf4, 0x7d, 0x30, 0x85, 0x3b, 0x9d, 0x90, 0x70, 0x6e, 0x68,
-	0x0d, 0x6d, 0x6d, 0xd6, 0xde, 0xf8, 0x9d, 0xc0, 0x66, 0xd7, 0x17, 0x27, 0x91, 0xdb, 0xf2, 0x18,
-	0xcd, 0x35, 0xf3, 0xbf, 0x26, 0xef, 0x9c, 0xae, 0x8b, 0x38, 0x20, 0xbc, 0xb5, 0xe3, 0x79, 0x3b,
-	0x8a, 0x88, 0xae, 0x15, 0xf4, 0xe7, 0x60, 0x2a, 0x88, 0x5c, 0xe7, 0x94, 0xc4, 0xc6, 0x84, 0x14,
-	0x6b, 0xfe, 0x4a, 0x60, 0x2d, 0x88, 0xdc, 0x9e, 0xef, 0x65, 0xe8, 0x03, 0x46, 0x7d, 0x41, 0x68,
-	0x20, 0xe2, 0x34, 0x81, 0x0b, 0x31, 0xa6, 0xbd, 0xb6, 0x75, 0x93, 0xb5, 0xd0, 0x64, 0x10, 0xb9,
-	0xfb, 0x24, 0xd6, 0x9f, 0x80, 0x79, 0xac, 0xe6, 0x73, 0xfa, 0x11, 0x75, 0x49, 0x68, 0x94, 0x1b,
-	0xda, 0x5a, 0xc5, 0x5e, 0x4d, 0x13, 0xb8, 0xa4, 0x68, 0xe3, 0x79, 0x0b, 0xcd, 0xe5, 0xc0, 0xa1,
-	0x8c, 0xf5, 0x3a, 0x98, 0xe6, 0xe4, 0x2c, 0x22, 0x7d, 0x8f, 0x18, 0x95, 0x8c, 0x8b, 0x46, 0x71,
-	0xbb, 0xf6, 0xee, 0x02, 0x96, 0x3e, 0x5e, 0xc0, 0xd2, 0xd7, 0x4f, 0xcd, 0xe9, 0xdc, 0x87, 0x3d,
-	0xeb, 0xb3, 0x06, 0xe6, 0x0e, 0x58, 0x27, 0xea, 0x8d, 0xac, 0x79, 0x05, 0x66, 0x5d, 0xcc, 0x89,
-	0x93, 0x2b, 0x4b, 0x7f, 0xaa, 0x9b, 0x46, 0xab, 0xe0, 0x7f, 0xab, 0x60, 0xa5, 0xfd, 0xff, 0x65,
-	0x02, 0xb5, 0x34, 0x81, 0x8b, 0x6a, 0xc2, 0x22, 0xd7, 0x42, 0x55, 0xb7, 0x60, 0xba, 0x0e, 0x2a,
-	0x7d, 0x4c, 0x89, 0x34, 0x69, 0x06, 0xc9, 0xb3, 0xde, 0x00, 0xd5, 0x80, 0x84, 0xd4, 0xe7, 0xdc,
-	0x67, 0x7d, 0x6e, 0x94, 0x1b, 0xe5, 0xb5, 0x19, 0x54, 0x84, 0xda, 0xf5, 0xc2, 0xdc, 0xf3, 0x63,
-	0xa3, 0xee, 0x59, 0xdf, 0xcb, 0x60, 0xf2, 0x08, 0x87, 0x98, 0x72, 0xfd, 0x10, 0x2c, 0x52, 0x3c,
-	0x70, 0x28, 0xa1, 0xcc, 0xf1, 0x4e, 0x70, 0x88, 0x3d, 0x41, 0x42, 0xb5, 0xdd, 0x8a, 0x6d, 0xa6,
-	0x09, 0xac, 0xab, 0xf9, 0xee, 0x28, 0xb2, 0xd0, 0x02, 0xc5, 0x83, 0x03, 0x42, 0xd9, 0xee, 0x08,
-	0xd3, 0xb7, 0xc1, 0xac, 0x18, 0x38, 0xdc, 0xef, 0x3a, 0x3d, 0x9f, 0xfa, 0x42, 0x0e, 0x5d, 0xb1,
-	0x57, 0x6e, 0x3e, 0xb4, 0x98, 0xb5, 0x10, 0x10, 0x83, 0x63, 0xbf, 0xfb, 0x22, 0x0b, 0x74, 0x04,
-	0x96, 0x64, 0xf2, 0x9c, 0x38, 0x1e, 0xe3, 0xc2, 0x09, 0x48, 0xe8, 0xb8, 0xb1, 0x20, 0xf9, 0x3a,
-	0x1b, 0x69, 0x02, 0xef, 0x15, 0x34, 0x6e, 0x97, 0x59, 0x68, 0x21, 0x13, 0x3b, 0x27, 0xbb, 0x8c,
-	0x8b, 0x23, 0x12, 0xda, 0xb1, 0x20, 0xfa, 0x19, 0x58, 0xc9, 0xba, 0xbd, 0x25, 0xa1, 0xff, 0x26,
-	0x56, 0xf5, 0xa4, 0xb3, 0xb9, 0xb5, 0xb5, 0xb1, 0xad, 0x16, 0x6d, 0xb7, 0x87, 0x09, 0xac, 0x1d,
-	0xfb, 0xdd, 0x97, 0xb2, 0x22, 0xa3, 0x3e, 0x7b, 0x2a, 0xf3, 0x69, 0x02, 0x4d, 0xd5, 0xed, 0x2f,
-	0x02, 0x16, 0xaa, 0xf1, 0x31, 0x9e, 0x82, 0xf5, 0x18, 0xac, 0xde, 0x66, 0x70, 0xe2, 0x05, 0x9b,
-	0x5b, 0x8f, 0x4e, 0x37, 0x8c, 0xff, 0x64, 0xd3, 0xc7, 0xc3, 0x04, 0x2e, 0x8f, 0x35, 0x3d, 0xbe,
-	0xae, 0x48, 0x13, 0xd8, 0xb8, 0xbb, 0xed, 0x48, 0xc4, 0x42, 0xcb, 0xfc, 0x4e, 0x6e, 0x7b, 0x3a,
-	0xdb, 0xf7, 0xcf, 0x0b, 0xa8, 0xd9, 0xbb, 0x5f, 0x86, 0xa6, 0x76, 0x39, 0x34, 0xb5, 0x1f, 0x43,
-	0x53, 0x7b, 0x7f, 0x65, 0x96, 0x2e, 0xaf, 0xcc, 0xd2, 0xb7, 0x2b, 0xb3, 0xf4, 0xfa, 0xfe, 0x3f,
-	0x6f, 0xeb, 0x40, 0x3d, 0x20, 0xf2, 0xd2, 0xba, 0x93, 0xf2, 0x11, 0x78, 0xf8, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x10, 0x68, 0x06, 0x78, 0x5c, 0x04, 0x00, 0x00,
+	// 697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0xc2, 0xba, 0xc0, 0x2c, 0x10, 0x29, 0x0b, 0x94, 0xd5, 0x74, 0x36, 0x3d, 0x18, 0x4c,
+	0xdc, 0x25, 0x60, 0x30, 0x61, 0x0f, 0x46, 0x0a, 0x9a, 0x10, 0x84, 0x90, 0x21, 0x31, 0xc6, 0x4b,
+	0x33, 0xed, 0x0e, 0xa5, 0x61, 0xbb, 0x53, 0x3a, 0x53, 0xb3, 0xe5, 0x17, 0x78, 0xf4, 0x64, 0x3c,
+	0xf2, 0x23, 0xbc, 0xf9, 0x07, 0x38, 0x12, 0xe3, 0xc1, 0x78, 0x68, 0xcc, 0x72, 0x31, 0x1e, 0x7b,
+	0xf4, 0x64, 0x3a, 0xb3, 0x2c, 0x5d, 0x82, 0xc6, 0x4b, 0x3b, 0xef, 0x7b, 0xef, 0xfb, 0xde, 0xeb,
+	0x37, 0xd3, 0x01, 0xf3, 0x0e, 0x65, 0x3e, 0x65, 0xcb, 0x38, 0xe2, 0x47, 0xe2, 0xd1, 0x08, 0x42,
+	0xca, 0xa9, 0x5a, 0x96, 0x78, 0x23, 0x83, 0xaa, 0x8b, 0x32, 0xb0, 0x44, 0x6a, 0xb9, 0x9f, 0x11,
+	0x41, 0xb5, 0xe2, 0x52, 0x97, 0x4a, 0x3c, 0x5b, 0x49, 0xd4, 0xf8, 0x30, 0x02, 0xca, 0x26, 0x66,
+	0x64, 0xc3, 0x71, 0x68, 0xd4, 0xe1, 0xea, 0x0e, 0x18, 0xc3, 0xad, 0x56, 0x48, 0x18, 0xd3, 0x94,
+	0x9a, 0xb2, 0x34, 0x69, 0xae, 0xfc, 0x4e, 0x60, 0xdd, 0xf5, 0xf8, 0x51, 0x64, 0x37, 0x1c, 0xea,
+	0xf7, 0x35, 0xfb, 0xaf, 0x3a, 0x6b, 0x1d, 0x2f, 0xf3, 0x38, 0x20, 0xac, 0xb1, 0xe1, 0x38, 0x1b,
+	0x92, 0x88, 0xae, 0x14, 0xd4, 0x17, 0x60, 0x2c, 0x88, 0x6c, 0xeb, 0x98, 0xc4, 0xda, 0x88, 0x10,
+	0xab, 0xff, 0x4a, 0x60, 0x25, 0x88, 0xec, 0xb6, 0xe7, 0x64, 0xe8, 0x23, 0xea, 0x7b, 0x9c, 0xf8,
+	0x01, 0x8f, 0xd3, 0x04, 0xce, 0xc4, 0xd8, 0x6f, 0x37, 0x8d, 0xeb, 0xac, 0x81, 0x4a, 0x41, 0x64,
+	0xef, 0x90, 0x58, 0x7d, 0x06, 0xa6, 0xb1, 0x9c, 0xcf, 0xea, 0x44, 0xbe, 0x4d, 0x42, 0x6d, 0xb4,
+	0xa6, 0x2c, 0x15, 0xcd, 0xc5, 0x34, 0x81, 0x73, 0x92, 0x36, 0x9c, 0x37, 0xd0, 0x54, 0x1f, 0xd8,
+	0x13, 0xb1, 0x5a, 0x05, 0xe3, 0x8c, 0x9c, 0x44, 0xa4, 0xe3, 0x10, 0xad, 0x98, 0x71, 0xd1, 0x20,
+	0x6e, 0x56, 0xde, 0x9d, 0xc1, 0xc2, 0xc7, 0x33, 0x58, 0xf8, 0xf2, 0xa9, 0x3e, 0xde, 0xf7, 0x61,
+	0xdb, 0xf8, 0xac, 0x80, 0xa9, 0x5d, 0xda, 0x8a, 0xda, 0x03, 0x6b, 0x5e, 0x83, 0x49, 0x1b, 0x33,
+	0x62, 0xf5, 0x95, 0x85, 0x3f, 0xe5, 0x55, 0xad, 0x91, 0xf3, 0xbf, 0x91, 0xb3, 0xd2, 0xbc, 0x77,
+	0x91, 0x40, 0x25, 0x4d, 0xe0, 0xac, 0x9c, 0x30, 0xcf, 0x35, 0x50, 0xd9, 0xce, 0x99, 0xae, 0x82,
+	0x62, 0x07, 0xfb, 0x44, 0x98, 0x34, 0x81, 0xc4, 0x5a, 0xad, 0x81, 0x72, 0x40, 0x42, 0xdf, 0x63,
+	0xcc, 0xa3, 0x1d, 0xa6, 0x8d, 0xd6, 0x46, 0x97, 0x26, 0x50, 0x1e, 0x6a, 0x56, 0x73, 0x73, 0x4f,
+	0x0f, 0x8d, 0xba, 0x6d, 0x7c, 0x2d, 0x82, 0xd2, 0x3e, 0x0e, 0xb1, 0xcf, 0xd4, 0x3d, 0x30, 0xeb,
+	0xe3, 0xae, 0xe5, 0x13, 0x9f, 0x5a, 0xce, 0x11, 0x0e, 0xb1, 0xc3, 0x49, 0x28, 0x77, 0xb7, 0x68,
+	0xea, 0x69, 0x02, 0xab, 0x72, 0xbe, 0x5b, 0x8a, 0x0c, 0x34, 0xe3, 0xe3, 0xee, 0x2e, 0xf1, 0xe9,
+	0xe6, 0x00, 0x53, 0xd7, 0xc1, 0x24, 0xef, 0x5a, 0xcc, 0x73, 0xad, 0xb6, 0xe7, 0x7b, 0x5c, 0x0c,
+	0x5d, 0x34, 0x17, 0xae, 0x3f, 0x34, 0x9f, 0x35, 0x10, 0xe0, 0xdd, 0x03, 0xcf, 0x7d, 0x99, 0x05,
+	0x2a, 0x02, 0x73, 0x22, 0x79, 0x4a, 0x2c, 0x87, 0x32, 0x6e, 0x05, 0x24, 0xb4, 0xec, 0x98, 0x93,
+	0xfe, 0x76, 0xd6, 0xd2, 0x04, 0xde, 0xcf, 0x69, 0xdc, 0x2c, 0x33, 0xd0, 0x4c, 0x26, 0x76, 0x4a,
+	0x36, 0x29, 0xe3, 0xfb, 0x24, 0x34, 0x63, 0x4e, 0xd4, 0x13, 0xb0, 0x90, 0x75, 0x7b, 0x4b, 0x42,
+	0xef, 0x30, 0x96, 0xf5, 0xa4, 0xb5, 0xba, 0xb6, 0xb6, 0xb2, 0x2e, 0x37, 0xda, 0x6c, 0xf6, 0x12,
+	0x58, 0x39, 0xf0, 0xdc, 0x57, 0xa2, 0x22, 0xa3, 0x3e, 0xdf, 0x12, 0xf9, 0x34, 0x81, 0xba, 0xec,
+	0xf6, 0x17, 0x01, 0x03, 0x55, 0xd8, 0x10, 0x4f, 0xc2, 0x6a, 0x0c, 0x16, 0x6f, 0x32, 0x18, 0x71,
+	0x82, 0xd5, 0xb5, 0x27, 0xc7, 0x2b, 0xda, 0x1d, 0xd1, 0xf4, 0x69, 0x2f, 0x81, 0xf3, 0x43, 0x4d,
+	0x0f, 0xae, 0x2a, 0xd2, 0x04, 0xd6, 0x6e, 0x6f, 0x3b, 0x10, 0x31, 0xd0, 0x3c, 0xbb, 0x95, 0xab,
+	0x32, 0x70, 0xd7, 0xc5, 0xcc, 0x0a, 0xc9, 0x61, 0xd4, 0x69, 0x59, 0x21, 0xe6, 0x1e, 0xd5, 0x4a,
+	0xd9, 0xa9, 0x31, 0xb7, 0xcf, 0x13, 0x58, 0xf8, 0x9e, 0xc0, 0x07, 0xff, 0xf1, 0xaf, 0x6e, 0x11,
+	0x27, 0x4d, 0xe0, 0x82, 0x9c, 0xe2, 0xa6, 0x9e, 0x81, 0xa6, 0x5d, 0xcc, 0x90, 0x40, 0x50, 0x06,
+	0x34, 0xc7, 0xb3, 0x43, 0xf6, 0xf3, 0x0c, 0x2a, 0xe6, 0xe6, 0x79, 0x4f, 0x57, 0x2e, 0x7a, 0xba,
+	0xf2, 0xa3, 0xa7, 0x2b, 0xef, 0x2f, 0xf5, 0xc2, 0xc5, 0xa5, 0x5e, 0xf8, 0x76, 0xa9, 0x17, 0xde,
+	0x3c, 0xfc, 0x67, 0xdb, 0xae, 0xbc, 0xb5, 0x44, 0x77, 0xbb, 0x24, 0x6e, 0x9e, 0xc7, 0x7f, 0x06,
+	0x00, 0x07, 0x7c, 0xf7, 0x66, 0xd1, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -269,6 +275,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if !this.GasRefundRatio.Equal(that1.GasRefundRatio) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -389,6 +398,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GasRefundRatio.Size()
+		i -= size
+		if _, err := m.GasRefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -495,6 +514,8 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	l = m.GasRefundRatio.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -940,6 +961,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasRefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
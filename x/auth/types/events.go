@@ -0,0 +1,8 @@
+package types
+
+// auth module event types
+const (
+	EventTypeGasRefund = "gas_refund"
+
+	AttributeKeyFeePayer = "fee_payer"
+)
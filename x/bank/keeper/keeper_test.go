@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
@@ -530,7 +531,7 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr, addr2, newCoins))
 
 	events := ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(2, len(events))
+	suite.Require().Equal(3, len(events))
 
 	event1 := sdk.Event{
 		Type:       types.EventTypeTransfer,
@@ -557,8 +558,14 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 		tmkv.Pair{Key: []byte(types.AttributeKeySender), Value: []byte(addr.String())},
 	)
 
+	// the legacy events are followed by the typed event
+	typedEvent := &types.EventTransfer{Sender: addr.String(), Recipient: addr2.String(), Amount: newCoins}
+
 	suite.Require().Equal(abci.Event(event1), events[0])
 	suite.Require().Equal(abci.Event(event2), events[1])
+	parsed, err := sdk.ParseTypedEvent(events[2])
+	suite.Require().NoError(err)
+	suite.Require().Equal(typedEvent, parsed)
 
 	app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50)))
 	newCoins = sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50))
@@ -566,9 +573,12 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr, addr2, newCoins))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(4, len(events))
-	suite.Require().Equal(abci.Event(event1), events[2])
-	suite.Require().Equal(abci.Event(event2), events[3])
+	suite.Require().Equal(6, len(events))
+	suite.Require().Equal(abci.Event(event1), events[3])
+	suite.Require().Equal(abci.Event(event2), events[4])
+	parsed, err = sdk.ParseTypedEvent(events[5])
+	suite.Require().NoError(err)
+	suite.Require().Equal(typedEvent, parsed)
 }
 
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
@@ -608,7 +618,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(1, len(events))
+	suite.Require().Equal(2, len(events))
 
 	event1 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -619,6 +629,9 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		tmkv.Pair{Key: []byte(types.AttributeKeySender), Value: []byte(addr.String())},
 	)
 	suite.Require().Equal(abci.Event(event1), events[0])
+	parsed, err := sdk.ParseTypedEvent(events[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.EventCoinSpent{Spender: addr.String(), Amount: newCoins}, parsed)
 
 	// Set addr's coins and addr2's coins
 	app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50)))
@@ -630,7 +643,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(5, len(events))
+	suite.Require().Equal(10, len(events))
 
 	event2 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -664,10 +677,23 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		tmkv.Pair{Key: []byte(sdk.AttributeKeyAmount), Value: []byte(newCoins2.String())},
 	)
 
-	suite.Require().Equal(abci.Event(event1), events[1])
-	suite.Require().Equal(abci.Event(event2), events[2])
-	suite.Require().Equal(abci.Event(event3), events[3])
-	suite.Require().Equal(abci.Event(event4), events[4])
+	// each legacy event is followed by its typed event
+	suite.Require().Equal(abci.Event(event1), events[2])
+	suite.Require().Equal(abci.Event(event2), events[4])
+	suite.Require().Equal(abci.Event(event3), events[6])
+	suite.Require().Equal(abci.Event(event4), events[8])
+
+	typedEvents := []proto.Message{
+		&types.EventCoinSpent{Spender: addr.String(), Amount: newCoins},
+		&types.EventCoinSpent{Spender: addr2.String(), Amount: newCoins2},
+		&types.EventCoinReceived{Receiver: addr3.String(), Amount: newCoins},
+		&types.EventCoinReceived{Receiver: addr4.String(), Amount: newCoins2},
+	}
+	for i, typedEvent := range typedEvents {
+		parsed, err := sdk.ParseTypedEvent(events[2*i+3])
+		suite.Require().NoError(err)
+		suite.Require().Equal(typedEvent, parsed)
+	}
 }
 
 func (suite *IntegrationTestSuite) TestSpendableCoins() {
@@ -96,6 +96,11 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 				sdk.NewAttribute(types.AttributeKeySender, in.Address.String()),
 			),
 		)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCoinSpent{Spender: in.Address.String(), Amount: in.Coins})
+		if err != nil {
+			return err
+		}
 	}
 
 	for _, out := range outputs {
@@ -112,6 +117,11 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 			),
 		)
 
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCoinReceived{Receiver: out.Address.String(), Amount: out.Coins})
+		if err != nil {
+			return err
+		}
+
 		// Create account if recipient does not exist.
 		//
 		// NOTE: This should ultimately be removed in favor a more flexible approach
@@ -142,7 +152,16 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	_, err := k.SubtractCoins(ctx, fromAddr, amt)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTransfer{
+		Sender:    fromAddr.String(),
+		Recipient: toAddr.String(),
+		Amount:    amt,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = k.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,29 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CircuitBreakerDecorator rejects the txs including a message disabled by a
+// tripped circuit, before their signatures are verified and their fees
+// deducted.
+type CircuitBreakerDecorator struct {
+	cb CircuitBreaker
+}
+
+func NewCircuitBreakerDecorator(cb CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		cb: cb,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if msgTypeURL := sdk.MsgTypeURL(msg); !cbd.cb.IsAllowed(ctx, msgTypeURL) {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrCircuitTripped, msgTypeURL)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
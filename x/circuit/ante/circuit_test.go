@@ -0,0 +1,41 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/ante"
+)
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewCircuitBreakerDecorator(app.CircuitKeeper))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr)
+	tx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewStdFee(100000, nil), nil, "")
+
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	app.CircuitKeeper.TripCircuit(ctx, sdk.MsgTypeURL(msg))
+	_, err = antehandler(ctx, tx, false)
+	require.True(t, sdkerrors.ErrCircuitTripped.Is(err), err)
+
+	// disabled messages are rejected when simulating as well
+	_, err = antehandler(ctx, tx, true)
+	require.True(t, sdkerrors.ErrCircuitTripped.Is(err), err)
+
+	app.CircuitKeeper.ResetCircuit(ctx, sdk.MsgTypeURL(msg))
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+}
@@ -0,0 +1,10 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CircuitBreaker defines the contract needed for the CircuitBreakerDecorator.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, msgTypeURL string) bool
+}
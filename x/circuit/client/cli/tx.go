@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Proposal flags
+const (
+	FlagTrip  = "trip"
+	FlagReset = "reset"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/circuit transaction
+// commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTripCircuitBreakerTxCmd(),
+		NewResetCircuitBreakerTxCmd(),
+	)
+
+	return txCmd
+}
+
+// NewTripCircuitBreakerTxCmd returns a CLI command handler for creating a
+// MsgTripCircuitBreaker transaction.
+func NewTripCircuitBreakerTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-type-url]...",
+		Short: "Disable the messages of the given type URLs",
+		Long: `Disable the messages of the given type URLs, e.g. /cosmos.bank.MsgSend.
+The signer must be one of the pausers of the circuit breaker parameters.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewResetCircuitBreakerTxCmd returns a CLI command handler for creating a
+// MsgResetCircuitBreaker transaction.
+func NewResetCircuitBreakerTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-type-url]...",
+		Short: "Enable again the messages of the given type URLs",
+		Long: `Enable again the messages of the given type URLs, e.g. /cosmos.bank.MsgSend.
+The signer must be one of the pausers of the circuit breaker parameters.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitCircuitBreakerProposal implements a command handler for
+// submitting a circuit breaker proposal transaction.
+func NewCmdSubmitCircuitBreakerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker (--trip [msg-type-urls]) (--reset [msg-type-urls]) [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a circuit breaker proposal",
+		Long: `Submit a proposal disabling the messages of the comma separated type URLs
+given by --trip, and enabling again the ones given by --reset, along with an
+initial deposit.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			trip, err := cmd.Flags().GetString(FlagTrip)
+			if err != nil {
+				return err
+			}
+
+			reset, err := cmd.Flags().GetString(FlagReset)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCircuitBreakerProposal(title, description, splitMsgTypeURLs(trip), splitMsgTypeURLs(reset))
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagTrip, "", "Comma separated type URLs of the messages to disable")
+	cmd.Flags().String(FlagReset, "", "Comma separated type URLs of the messages to enable again")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func splitMsgTypeURLs(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+
+	msgTypeURLs := strings.Split(s, ",")
+	for i, typeURL := range msgTypeURLs {
+		msgTypeURLs[i] = strings.TrimSpace(typeURL)
+	}
+
+	return msgTypeURLs
+}
@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// CircuitBreakerProposalRequest defines a proposal tripping and resetting
+// circuits.
+type CircuitBreakerProposalRequest struct {
+	BaseReq          rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title            string       `json:"title" yaml:"title"`
+	Description      string       `json:"description" yaml:"description"`
+	Deposit          sdk.Coins    `json:"deposit" yaml:"deposit"`
+	TripMsgTypeURLs  []string     `json:"trip_msg_type_urls" yaml:"trip_msg_type_urls"`
+	ResetMsgTypeURLs []string     `json:"reset_msg_type_urls" yaml:"reset_msg_type_urls"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the circuit
+// breaker proposal REST handler with a given sub-route.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CircuitBreakerProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewCircuitBreakerProposal(req.Title, req.Description, req.TripMsgTypeURLs, req.ResetMsgTypeURLs)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
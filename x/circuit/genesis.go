@@ -0,0 +1,21 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit breaker module's state from a provided
+// genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	for _, msgTypeURL := range data.TrippedMsgTypeURLs {
+		k.TripCircuit(ctx, msgTypeURL)
+	}
+}
+
+// ExportGenesis returns the circuit breaker module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetTrippedCircuits(ctx))
+}
@@ -0,0 +1,30 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestInitAndExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	require.Equal(t, types.DefaultGenesisState(), circuit.ExportGenesis(ctx, app.CircuitKeeper))
+
+	genState := types.NewGenesisState(
+		types.NewParams([]string{sdk.AccAddress([]byte("pauser______________")).String()}),
+		[]string{"/cosmos.bank.MsgSend", "/cosmos.staking.MsgDelegate"},
+	)
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	circuit.InitGenesis(ctx, app.CircuitKeeper, genState)
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, "/cosmos.bank.MsgSend"))
+	require.Equal(t, genState, circuit.ExportGenesis(ctx, app.CircuitKeeper))
+}
@@ -0,0 +1,93 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewHandler returns a handler for the circuit breaker messages, which are only
+// accepted from the accounts the pause permission is delegated to.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgTripCircuitBreaker:
+			return handleMsgTripCircuitBreaker(ctx, k, msg)
+
+		case *types.MsgResetCircuitBreaker:
+			return handleMsgResetCircuitBreaker(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgTripCircuitBreaker(ctx sdk.Context, k keeper.Keeper, msg *types.MsgTripCircuitBreaker) (*sdk.Result, error) {
+	if !k.GetParams(ctx).IsPauser(msg.Authority) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedPauser, msg.Authority.String())
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeURLs {
+		k.TripCircuit(ctx, msgTypeURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgResetCircuitBreaker(ctx sdk.Context, k keeper.Keeper, msg *types.MsgResetCircuitBreaker) (*sdk.Result, error) {
+	if !k.GetParams(ctx).IsPauser(msg.Authority) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedPauser, msg.Authority.String())
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeURLs {
+		k.ResetCircuit(ctx, msgTypeURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// NewCircuitBreakerProposalHandler creates a governance handler tripping and
+// resetting the circuits of a CircuitBreakerProposal.
+func NewCircuitBreakerProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CircuitBreakerProposal:
+			return handleCircuitBreakerProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized circuit breaker proposal content type: %T", c)
+		}
+	}
+}
+
+func handleCircuitBreakerProposal(ctx sdk.Context, k keeper.Keeper, p *types.CircuitBreakerProposal) error {
+	for _, msgTypeURL := range p.TripMsgTypeURLs {
+		k.TripCircuit(ctx, msgTypeURL)
+	}
+	for _, msgTypeURL := range p.ResetMsgTypeURLs {
+		k.ResetCircuit(ctx, msgTypeURL)
+	}
+
+	return nil
+}
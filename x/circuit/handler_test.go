@@ -0,0 +1,64 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestHandleMsgTripAndResetCircuitBreaker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+	pauser, other := addrs[0], addrs[1]
+
+	app.CircuitKeeper.SetParams(ctx, types.NewParams([]string{pauser.String()}))
+	handler := circuit.NewHandler(app.CircuitKeeper)
+
+	msgSend := banktypes.NewMsgSend(pauser, other, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	msgSendTypeURL := sdk.MsgTypeURL(msgSend)
+
+	// only the pausers can trip circuits
+	_, err := handler(ctx, types.NewMsgTripCircuitBreaker(other, []string{msgSendTypeURL}))
+	require.True(t, types.ErrUnauthorizedPauser.Is(err))
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, msgSendTypeURL))
+
+	res, err := handler(ctx, types.NewMsgTripCircuitBreaker(pauser, []string{msgSendTypeURL}))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeTripCircuit, res.Events[0].Type)
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, msgSendTypeURL))
+
+	// only the pausers can reset circuits
+	_, err = handler(ctx, types.NewMsgResetCircuitBreaker(other, []string{msgSendTypeURL}))
+	require.True(t, types.ErrUnauthorizedPauser.Is(err))
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, msgSendTypeURL))
+
+	res, err = handler(ctx, types.NewMsgResetCircuitBreaker(pauser, []string{msgSendTypeURL}))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeResetCircuit, res.Events[0].Type)
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, msgSendTypeURL))
+}
+
+func TestCircuitBreakerProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := circuit.NewCircuitBreakerProposalHandler(app.CircuitKeeper)
+
+	app.CircuitKeeper.TripCircuit(ctx, "/cosmos.staking.MsgDelegate")
+
+	proposal := types.NewCircuitBreakerProposal(
+		"title", "description", []string{"/cosmos.bank.MsgSend"}, []string{"/cosmos.staking.MsgDelegate"},
+	)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, handler(ctx, proposal))
+
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, "/cosmos.bank.MsgSend"))
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, "/cosmos.staking.MsgDelegate"))
+}
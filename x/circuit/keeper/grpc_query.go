@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the circuit breaker module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// TrippedCircuits returns the type URLs of the disabled messages.
+func (k Keeper) TrippedCircuits(c context.Context, req *types.QueryTrippedCircuitsRequest) (*types.QueryTrippedCircuitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TrippedCircuitPrefix)
+
+	msgTypeURLs := []string{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		msgTypeURLs = append(msgTypeURLs, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTrippedCircuitsResponse{MsgTypeURLs: msgTypeURLs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the circuit breaker store
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper creates a new circuit breaker Keeper instance
+func NewKeeper(key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   key,
+		paramSpace: paramSpace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of circuit breaker parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of circuit breaker parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsAllowed returns false if the messages of the given type URL are disabled
+// by a tripped circuit.
+func (k Keeper) IsAllowed(ctx sdk.Context, msgTypeURL string) bool {
+	return !ctx.KVStore(k.storeKey).Has(types.TrippedCircuitKey(msgTypeURL))
+}
+
+// TripCircuit disables the messages of the given type URL.
+func (k Keeper) TripCircuit(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Set(types.TrippedCircuitKey(msgTypeURL), []byte{0x01})
+	k.Logger(ctx).Info("circuit tripped", "msg_type_url", msgTypeURL)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTripCircuit,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+}
+
+// ResetCircuit enables again the messages of the given type URL.
+func (k Keeper) ResetCircuit(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Delete(types.TrippedCircuitKey(msgTypeURL))
+	k.Logger(ctx).Info("circuit reset", "msg_type_url", msgTypeURL)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetCircuit,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+}
+
+// IterateTrippedCircuits iterates over the type URLs of the disabled messages,
+// in lexicographical order, until the callback returns true.
+func (k Keeper) IterateTrippedCircuits(ctx sdk.Context, cb func(msgTypeURL string) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TrippedCircuitPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()[len(types.TrippedCircuitPrefix):])) {
+			break
+		}
+	}
+}
+
+// GetTrippedCircuits returns the type URLs of all the disabled messages.
+func (k Keeper) GetTrippedCircuits(ctx sdk.Context) []string {
+	msgTypeURLs := []string{}
+	k.IterateTrippedCircuits(ctx, func(msgTypeURL string) bool {
+		msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+		return false
+	})
+
+	return msgTypeURLs
+}
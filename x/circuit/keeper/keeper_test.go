@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+const (
+	msgSendTypeURL     = "/cosmos.bank.MsgSend"
+	msgDelegateTypeURL = "/cosmos.staking.MsgDelegate"
+	msgVoteTypeURL     = "/cosmos.gov.MsgVote"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+
+	suite.queryClient = queryClient
+}
+
+func (suite *KeeperTestSuite) TestTripAndResetCircuit() {
+	app, ctx := suite.app, suite.ctx
+
+	suite.Require().True(app.CircuitKeeper.IsAllowed(ctx, msgSendTypeURL))
+	suite.Require().Empty(app.CircuitKeeper.GetTrippedCircuits(ctx))
+
+	app.CircuitKeeper.TripCircuit(ctx, msgSendTypeURL)
+	app.CircuitKeeper.TripCircuit(ctx, msgDelegateTypeURL)
+	suite.Require().False(app.CircuitKeeper.IsAllowed(ctx, msgSendTypeURL))
+	suite.Require().False(app.CircuitKeeper.IsAllowed(ctx, msgDelegateTypeURL))
+	suite.Require().True(app.CircuitKeeper.IsAllowed(ctx, msgVoteTypeURL))
+	suite.Require().Equal([]string{msgSendTypeURL, msgDelegateTypeURL}, app.CircuitKeeper.GetTrippedCircuits(ctx))
+
+	app.CircuitKeeper.ResetCircuit(ctx, msgSendTypeURL)
+	suite.Require().True(app.CircuitKeeper.IsAllowed(ctx, msgSendTypeURL))
+	suite.Require().Equal([]string{msgDelegateTypeURL}, app.CircuitKeeper.GetTrippedCircuits(ctx))
+
+	// resetting a circuit which is not tripped is a no-op
+	app.CircuitKeeper.ResetCircuit(ctx, msgVoteTypeURL)
+	suite.Require().Equal([]string{msgDelegateTypeURL}, app.CircuitKeeper.GetTrippedCircuits(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGRPCParams() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	pausers := []string{sdk.AccAddress([]byte("pauser______________")).String()}
+	app.CircuitKeeper.SetParams(ctx, types.NewParams(pausers))
+
+	res, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pausers, res.Params.Pausers)
+}
+
+func (suite *KeeperTestSuite) TestGRPCTrippedCircuits() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.TrippedCircuits(gocontext.Background(), &types.QueryTrippedCircuitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.MsgTypeURLs)
+
+	app.CircuitKeeper.TripCircuit(ctx, msgSendTypeURL)
+	app.CircuitKeeper.TripCircuit(ctx, msgDelegateTypeURL)
+	app.CircuitKeeper.TripCircuit(ctx, msgVoteTypeURL)
+
+	res, err = queryClient.TrippedCircuits(gocontext.Background(), &types.QueryTrippedCircuitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{msgSendTypeURL, msgVoteTypeURL, msgDelegateTypeURL}, res.MsgTypeURLs)
+
+	res, err = queryClient.TrippedCircuits(gocontext.Background(), &types.QueryTrippedCircuitsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{msgSendTypeURL, msgVoteTypeURL}, res.MsgTypeURLs)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.TrippedCircuits(gocontext.Background(), &types.QueryTrippedCircuitsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{msgDelegateTypeURL}, res.MsgTypeURLs)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
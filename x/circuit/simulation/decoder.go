@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// NewDecodeStore returns a decoder function closure that decodes the KVPair of
+// the circuit breaker store.
+func NewDecodeStore() func(kvA, kvB tmkv.Pair) string {
+	return func(kvA, kvB tmkv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.TrippedCircuitPrefix):
+			return fmt.Sprintf("%s\n%s",
+				kvA.Key[len(types.TrippedCircuitPrefix):], kvB.Key[len(types.TrippedCircuitPrefix):])
+
+		default:
+			panic(fmt.Sprintf("invalid circuit breaker key %X", kvA.Key))
+		}
+	}
+}
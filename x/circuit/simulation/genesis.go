@@ -0,0 +1,43 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Simulation parameter constants
+const Pausers = "pausers"
+
+// GenPausers randomized Pausers
+func GenPausers(r *rand.Rand, accs []simtypes.Account) []string {
+	pausers := []string{}
+	for _, acc := range accs {
+		if r.Intn(10) == 0 {
+			pausers = append(pausers, acc.Address.String())
+		}
+	}
+
+	return pausers
+}
+
+// RandomizedGenState generates a random GenesisState for the circuit breaker.
+// No circuit is tripped, so that all the simulated messages remain enabled.
+func RandomizedGenState(simState *module.SimulationState) {
+	var pausers []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Pausers, &pausers, simState.Rand,
+		func(r *rand.Rand) { pausers = GenPausers(r, simState.Accounts) },
+	)
+
+	circuitGenesis := types.NewGenesisState(types.NewParams(pausers), []string{})
+
+	fmt.Printf("Selected randomly generated circuit breaker parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, circuitGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(circuitGenesis)
+}
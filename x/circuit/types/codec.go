@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterCodec registers the necessary x/circuit interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgTripCircuitBreaker{}, "cosmos-sdk/MsgTripCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgResetCircuitBreaker{}, "cosmos-sdk/MsgResetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&CircuitBreakerProposal{}, "cosmos-sdk/CircuitBreakerProposal", nil)
+}
+
+// RegisterInterfaces registers the x/circuit messages and proposal content on
+// the provided InterfaceRegistry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTripCircuitBreaker{},
+		&MsgResetCircuitBreaker{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&CircuitBreakerProposal{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/circuit module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/circuit
+	// and defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
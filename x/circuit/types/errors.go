@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrNoAuthority        = sdkerrors.Register(ModuleName, 2, "authority address is empty")
+	ErrInvalidMsgTypeURL  = sdkerrors.Register(ModuleName, 3, "invalid message type URL")
+	ErrUnauthorizedPauser = sdkerrors.Register(ModuleName, 4, "account is not a pauser")
+)
@@ -0,0 +1,10 @@
+package types
+
+// circuit breaker module event types
+const (
+	EventTypeTripCircuit  = "trip_circuit"
+	EventTypeResetCircuit = "reset_circuit"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyMsgTypeURL = "msg_type_url"
+)
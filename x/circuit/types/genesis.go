@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, trippedMsgTypeURLs []string) GenesisState {
+	return GenesisState{
+		Params:             params,
+		TrippedMsgTypeURLs: trippedMsgTypeURLs,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object, in which no
+// circuit is tripped.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []string{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(data.TrippedMsgTypeURLs) == 0 {
+		return nil
+	}
+
+	if err := ValidateMsgTypeURLs(data.TrippedMsgTypeURLs); err != nil {
+		return fmt.Errorf("invalid tripped circuits: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	pauser := sdk.AccAddress([]byte("pauser______________")).String()
+
+	tests := []struct {
+		name       string
+		genState   types.GenesisState
+		expectPass bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"valid", types.NewGenesisState(types.NewParams([]string{pauser}), []string{"/cosmos.bank.MsgSend"}), true},
+		{"invalid pauser", types.NewGenesisState(types.NewParams([]string{"pauser"}), nil), false},
+		{"duplicate pauser", types.NewGenesisState(types.NewParams([]string{pauser, pauser}), nil), false},
+		{"invalid tripped circuit", types.NewGenesisState(types.DefaultParams(), []string{"cosmos.bank.MsgSend"}), false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(tc.genState)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
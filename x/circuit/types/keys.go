@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// TrippedCircuitPrefix is the prefix of the keys of the tripped circuits, which
+// are followed by the type URL of the disabled message.
+var TrippedCircuitPrefix = []byte{0x01}
+
+// TrippedCircuitKey returns the key of the circuit of the given message type URL.
+func TrippedCircuitKey(msgTypeURL string) []byte {
+	return append(TrippedCircuitPrefix, []byte(msgTypeURL)...)
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// circuit breaker message types
+const (
+	TypeMsgTripCircuitBreaker  = "trip_circuit_breaker"
+	TypeMsgResetCircuitBreaker = "reset_circuit_breaker"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker object
+func NewMsgTripCircuitBreaker(authority sdk.AccAddress, msgTypeURLs []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeURLs: msgTypeURLs,
+	}
+}
+
+func (msg MsgTripCircuitBreaker) Route() string { return RouterKey }
+func (msg MsgTripCircuitBreaker) Type() string  { return TypeMsgTripCircuitBreaker }
+
+// GetSigners returns the address of the authority tripping the circuits.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgTripCircuitBreaker
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs a stateless validation of the message.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return ErrNoAuthority
+	}
+
+	return ValidateMsgTypeURLs(msg.MsgTypeURLs)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker object
+func NewMsgResetCircuitBreaker(authority sdk.AccAddress, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeURLs: msgTypeURLs,
+	}
+}
+
+func (msg MsgResetCircuitBreaker) Route() string { return RouterKey }
+func (msg MsgResetCircuitBreaker) Type() string  { return TypeMsgResetCircuitBreaker }
+
+// GetSigners returns the address of the authority resetting the circuits.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgResetCircuitBreaker
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs a stateless validation of the message.
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return ErrNoAuthority
+	}
+
+	return ValidateMsgTypeURLs(msg.MsgTypeURLs)
+}
+
+// IsCircuitBreakerMsg returns true if the given type URL is the one of a message
+// of the circuit breaker module, which cannot be disabled.
+func IsCircuitBreakerMsg(msgTypeURL string) bool {
+	return msgTypeURL == sdk.MsgTypeURL(&MsgTripCircuitBreaker{}) ||
+		msgTypeURL == sdk.MsgTypeURL(&MsgResetCircuitBreaker{})
+}
+
+// IsGovernanceMsg returns true if the given type URL is the one of a message of
+// the governance module, which cannot be disabled so that the pausers cannot
+// prevent governance from overruling them, nor governance lock itself out.
+func IsGovernanceMsg(msgTypeURL string) bool {
+	return msgTypeURL == sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{}) ||
+		msgTypeURL == sdk.MsgTypeURL(&govtypes.MsgDeposit{}) ||
+		msgTypeURL == sdk.MsgTypeURL(&govtypes.MsgVote{})
+}
+
+// ValidateMsgTypeURLs checks that the given list of message type URLs is not
+// empty and holds distinct type URLs of messages that can be disabled.
+func ValidateMsgTypeURLs(msgTypeURLs []string) error {
+	if len(msgTypeURLs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgTypeURL, "no message type URL")
+	}
+
+	seen := make(map[string]bool, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		if err := ValidateMsgTypeURL(typeURL); err != nil {
+			return err
+		}
+		if seen[typeURL] {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "duplicate message type URL %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+// ValidateMsgTypeURL checks that the given message type URL is well formed and
+// is not the one of a circuit breaker or governance message.
+func ValidateMsgTypeURL(msgTypeURL string) error {
+	if !strings.HasPrefix(msgTypeURL, "/") || len(strings.TrimSpace(msgTypeURL)) < 2 {
+		return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%q must start with / followed by the message name", msgTypeURL)
+	}
+	if IsCircuitBreakerMsg(msgTypeURL) || IsGovernanceMsg(msgTypeURL) {
+		return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%s cannot be disabled", msgTypeURL)
+	}
+
+	return nil
+}
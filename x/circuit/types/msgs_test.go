@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestValidateMsgTypeURLs(t *testing.T) {
+	tests := []struct {
+		name        string
+		msgTypeURLs []string
+		expectPass  bool
+	}{
+		{"valid", []string{"/cosmos.bank.MsgSend", "/cosmos.staking.MsgDelegate"}, true},
+		{"empty", []string{}, false},
+		{"empty type URL", []string{""}, false},
+		{"missing slash", []string{"cosmos.bank.MsgSend"}, false},
+		{"slash only", []string{"/"}, false},
+		{"duplicate", []string{"/cosmos.bank.MsgSend", "/cosmos.bank.MsgSend"}, false},
+		{"trip circuit breaker", []string{sdk.MsgTypeURL(&types.MsgTripCircuitBreaker{})}, false},
+		{"reset circuit breaker", []string{sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{})}, false},
+		{"submit proposal", []string{sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{})}, false},
+		{"deposit", []string{sdk.MsgTypeURL(&govtypes.MsgDeposit{})}, false},
+		{"vote", []string{sdk.MsgTypeURL(&govtypes.MsgVote{})}, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMsgTypeURLs(tc.msgTypeURLs)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.True(t, types.ErrInvalidMsgTypeURL.Is(err), err)
+			}
+		})
+	}
+}
+
+func TestMsgTripCircuitBreaker(t *testing.T) {
+	addr := sdk.AccAddress([]byte("authority___________"))
+
+	msg := types.NewMsgTripCircuitBreaker(addr, []string{"/cosmos.bank.MsgSend"})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgTripCircuitBreaker, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.Equal(t, types.ErrNoAuthority, types.NewMsgTripCircuitBreaker(nil, []string{"/cosmos.bank.MsgSend"}).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuitBreaker(addr, nil).ValidateBasic())
+}
+
+func TestMsgResetCircuitBreaker(t *testing.T) {
+	addr := sdk.AccAddress([]byte("authority___________"))
+
+	msg := types.NewMsgResetCircuitBreaker(addr, []string{"/cosmos.bank.MsgSend"})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgResetCircuitBreaker, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.Equal(t, types.ErrNoAuthority, types.NewMsgResetCircuitBreaker(nil, []string{"/cosmos.bank.MsgSend"}).ValidateBasic())
+	require.Error(t, types.NewMsgResetCircuitBreaker(addr, nil).ValidateBasic())
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// KeyPausers is the parameter key of the pausers.
+var KeyPausers = []byte("Pausers")
+
+var _ paramtypes.ParamSet = &Params{}
+
+// NewParams creates a new Params object
+func NewParams(pausers []string) Params {
+	return Params{
+		Pausers: pausers,
+	}
+}
+
+// DefaultParams returns default parameters, which delegate the pause permission
+// to no account.
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// ParamKeyTable returns the parameter key table of the circuit breaker module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of the circuit breaker module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPausers, &p.Pausers, validatePausers),
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	return validatePausers(p.Pausers)
+}
+
+// IsPauser returns true if the pause permission is delegated to the given
+// address.
+func (p Params) IsPauser(addr sdk.AccAddress) bool {
+	for _, pauser := range p.Pausers {
+		if pauser == addr.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validatePausers(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, pauser := range v {
+		if _, err := sdk.AccAddressFromBech32(pauser); err != nil {
+			return fmt.Errorf("invalid pauser address %q: %w", pauser, err)
+		}
+		if seen[pauser] {
+			return fmt.Errorf("duplicate pauser address %s", pauser)
+		}
+		seen[pauser] = true
+	}
+
+	return nil
+}
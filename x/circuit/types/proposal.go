@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalTypeCircuitBreaker defines the type for a CircuitBreakerProposal
+const ProposalTypeCircuitBreaker = "CircuitBreaker"
+
+// Implements Proposal Interface
+var _ govtypes.Content = &CircuitBreakerProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCircuitBreaker)
+	govtypes.RegisterProposalTypeCodec(&CircuitBreakerProposal{}, "cosmos-sdk/CircuitBreakerProposal")
+}
+
+// NewCircuitBreakerProposal creates a new circuit breaker proposal.
+func NewCircuitBreakerProposal(title, description string, tripMsgTypeURLs, resetMsgTypeURLs []string) govtypes.Content {
+	return &CircuitBreakerProposal{
+		Title:            title,
+		Description:      description,
+		TripMsgTypeURLs:  tripMsgTypeURLs,
+		ResetMsgTypeURLs: resetMsgTypeURLs,
+	}
+}
+
+// GetTitle returns the title of a circuit breaker proposal.
+func (cbp *CircuitBreakerProposal) GetTitle() string { return cbp.Title }
+
+// GetDescription returns the description of a circuit breaker proposal.
+func (cbp *CircuitBreakerProposal) GetDescription() string { return cbp.Description }
+
+// ProposalRoute returns the routing key of a circuit breaker proposal.
+func (cbp *CircuitBreakerProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a circuit breaker proposal.
+func (cbp *CircuitBreakerProposal) ProposalType() string { return ProposalTypeCircuitBreaker }
+
+// ValidateBasic runs basic stateless validity checks
+func (cbp *CircuitBreakerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cbp); err != nil {
+		return err
+	}
+
+	if len(cbp.TripMsgTypeURLs) == 0 && len(cbp.ResetMsgTypeURLs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgTypeURL, "no message type URL to trip or reset")
+	}
+
+	tripped := make(map[string]bool, len(cbp.TripMsgTypeURLs))
+	if len(cbp.TripMsgTypeURLs) > 0 {
+		if err := ValidateMsgTypeURLs(cbp.TripMsgTypeURLs); err != nil {
+			return err
+		}
+		for _, typeURL := range cbp.TripMsgTypeURLs {
+			tripped[typeURL] = true
+		}
+	}
+
+	if len(cbp.ResetMsgTypeURLs) > 0 {
+		if err := ValidateMsgTypeURLs(cbp.ResetMsgTypeURLs); err != nil {
+			return err
+		}
+		for _, typeURL := range cbp.ResetMsgTypeURLs {
+			if tripped[typeURL] {
+				return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%s is both tripped and reset", typeURL)
+			}
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cbp CircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Circuit Breaker Proposal:
+  Title:       %s
+  Description: %s
+  Trip:        %s
+  Reset:       %s
+`, cbp.Title, cbp.Description, strings.Join(cbp.TripMsgTypeURLs, ", "), strings.Join(cbp.ResetMsgTypeURLs, ", "))
+}
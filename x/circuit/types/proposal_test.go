@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestCircuitBreakerProposal(t *testing.T) {
+	tests := []struct {
+		name       string
+		trip       []string
+		reset      []string
+		expectPass bool
+	}{
+		{"trip", []string{"/cosmos.bank.MsgSend"}, nil, true},
+		{"reset", nil, []string{"/cosmos.bank.MsgSend"}, true},
+		{"trip and reset", []string{"/cosmos.bank.MsgSend"}, []string{"/cosmos.staking.MsgDelegate"}, true},
+		{"nothing", nil, nil, false},
+		{"invalid trip", []string{"cosmos.bank.MsgSend"}, nil, false},
+		{"invalid reset", nil, []string{"cosmos.bank.MsgSend"}, false},
+		{"tripped and reset", []string{"/cosmos.bank.MsgSend"}, []string{"/cosmos.bank.MsgSend"}, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.NewCircuitBreakerProposal("title", "description", tc.trip, tc.reset)
+			require.Equal(t, types.RouterKey, proposal.ProposalRoute())
+			require.Equal(t, types.ProposalTypeCircuitBreaker, proposal.ProposalType())
+
+			err := proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package feemarket
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker computes the base gas price of the next block from the gas used
+// by the current one, against the gas targeted given the maximum block gas.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	// the limit of the block gas meter is the maximum block gas, or zero if
+	// it is unlimited
+	gasUsed := ctx.BlockGasMeter().GasConsumed()
+	maxBlockGas := ctx.BlockGasMeter().Limit()
+
+	baseGasPrice := types.NextBaseGasPrice(params, k.GetBaseGasPrice(ctx), gasUsed, maxBlockGas)
+	k.SetBaseGasPrice(ctx, baseGasPrice)
+	k.SetLastBlockGasUsed(ctx, gasUsed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseGasPrice,
+			sdk.NewAttribute(types.AttributeKeyBaseGasPrice, baseGasPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, fmt.Sprint(gasUsed)),
+		),
+	)
+}
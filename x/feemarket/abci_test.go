@@ -0,0 +1,45 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{}).WithBlockGasMeter(sdk.NewGasMeter(1000000))
+	ctx.BlockGasMeter().ConsumeGas(1000000, "test")
+
+	// the base gas price is left unchanged while the fee market is disabled
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, types.DefaultMinBaseGasPrice, app.FeeMarketKeeper.GetBaseGasPrice(ctx))
+	require.Equal(t, uint64(0), app.FeeMarketKeeper.GetLastBlockGasUsed(ctx))
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.Enabled = true
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.OneDec())
+
+	// a full block raises the base gas price by 1/8
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3), app.FeeMarketKeeper.GetBaseGasPrice(ctx))
+	require.Equal(t, uint64(1000000), app.FeeMarketKeeper.GetLastBlockGasUsed(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeBaseGasPrice, events[0].Type)
+
+	// an empty block lowers it by 1/8
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000000))
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(984375, 6), app.FeeMarketKeeper.GetBaseGasPrice(ctx))
+	require.Equal(t, uint64(0), app.FeeMarketKeeper.GetLastBlockGasUsed(ctx))
+}
@@ -0,0 +1,37 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewAnteHandler returns the AnteHandler of x/auth, in which the fee decorators
+// are replaced by the DeductFeeDecorator enforcing and burning the base fee.
+func NewAnteHandler(
+	ak authante.AccountKeeper, bankKeeper authtypes.BankKeeper, fmk FeeMarketKeeper,
+	sigGasConsumer authante.SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		authante.NewValidateBasicDecorator(),
+		authante.NewValidateMemoDecorator(ak),
+		authante.NewConsumeGasForTxSizeDecorator(ak),
+		authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewValidateSigCountDecorator(ak),
+		NewDeductFeeDecorator(ak, bankKeeper, fmk),
+		authante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		authante.NewSigVerificationDecorator(ak, signModeHandler),
+		authante.NewIncrementSequenceDecorator(ak),
+	)
+}
+
+// NewPostHandler returns the PostHandler of x/auth, in which the gas refund is
+// replaced by the RefundGasDecorator leaving out the burnt base fee.
+func NewPostHandler(ak authante.AccountKeeper, bankKeeper authtypes.BankKeeper, fmk FeeMarketKeeper) sdk.PostHandler {
+	return sdk.ChainPostDecorators(
+		NewRefundGasDecorator(ak, bankKeeper, fmk),
+	)
+}
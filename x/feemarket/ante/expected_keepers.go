@@ -0,0 +1,11 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeMarketKeeper defines the contract needed for the fee market decorators.
+type FeeMarketKeeper interface {
+	BaseFee(ctx sdk.Context, gas uint64) sdk.Coins
+	BurnBaseFee(ctx sdk.Context, fee sdk.Coins) error
+}
@@ -0,0 +1,134 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// DeductFeeDecorator replaces the MempoolFeeDecorator and DeductFeeDecorator of
+// x/auth. It checks that the fee of a tx covers the base fee of its gas limit at
+// the base gas price of the current block, in both CheckTx and DeliverTx, and
+// that it is at least as large as the local validator's minimum gas prices in
+// CheckTx. It then deducts the fee from the fee payer, and burns its base fee
+// portion from the fee collector. As the state transitions of the AnteHandler
+// are kept if the messages of the tx fail, the base fee is burnt whether or not
+// the tx succeeds. Burning does not consume the gas of the tx.
+// The checks and the burning are skipped when simulating, so that gas can be
+// estimated without a fee.
+// CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
+type DeductFeeDecorator struct {
+	mempoolFee authante.MempoolFeeDecorator
+	deductFee  authante.DeductFeeDecorator
+	fmk        FeeMarketKeeper
+}
+
+func NewDeductFeeDecorator(ak authante.AccountKeeper, bk authtypes.BankKeeper, fmk FeeMarketKeeper) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		mempoolFee: authante.NewMempoolFeeDecorator(),
+		deductFee:  authante.NewDeductFeeDecorator(ak, bk),
+		fmk:        fmk,
+	}
+}
+
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	var baseFee sdk.Coins
+	if !simulate {
+		baseFee = dfd.fmk.BaseFee(ctx, feeTx.GetGas())
+		if !baseFee.IsZero() && !feeTx.GetFee().IsAllGTE(baseFee) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee,
+				"insufficient fees; got: %s required base fee: %s", feeTx.GetFee(), baseFee)
+		}
+	}
+
+	burnBaseFee := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if !baseFee.IsZero() {
+			if err := dfd.fmk.BurnBaseFee(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), baseFee); err != nil {
+				return ctx, sdkerrors.Wrap(err, "failed to burn base fee")
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBurnBaseFee,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, baseFee.String()),
+				),
+			)
+		}
+
+		return next(ctx, tx, simulate)
+	}
+
+	return dfd.mempoolFee.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return dfd.deductFee.AnteHandle(ctx, tx, simulate, burnBaseFee)
+	})
+}
+
+// RefundGasDecorator replaces the RefundGasDecorator of x/auth. It refunds to
+// the fee payer of a tx the fraction, set by the GasRefundRatio parameter of
+// x/auth, of the priority fee paid for the gas wanted but not used by the tx,
+// the priority fee being the part of the fee above the base fee burnt by the
+// DeductFeeDecorator. The refund is sent from the fee collector, and does not
+// consume the gas of the tx.
+// CONTRACT: Tx must implement FeeTx interface to use RefundGasDecorator, and
+// the fees must have been deducted by the DeductFeeDecorator.
+type RefundGasDecorator struct {
+	ak         authante.AccountKeeper
+	bankKeeper authtypes.BankKeeper
+	fmk        FeeMarketKeeper
+}
+
+func NewRefundGasDecorator(ak authante.AccountKeeper, bk authtypes.BankKeeper, fmk FeeMarketKeeper) RefundGasDecorator {
+	return RefundGasDecorator{
+		ak:         ak,
+		bankKeeper: bk,
+		fmk:        fmk,
+	}
+}
+
+func (rgd RefundGasDecorator) PostHandle(
+	ctx sdk.Context, tx sdk.Tx, result *sdk.Result, simulate bool, next sdk.PostHandler,
+) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the gas used is measured before refunding, which consumes no gas
+	gasUsed := ctx.GasMeter().GasConsumed()
+	refundCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	// the fee of a simulated tx may not cover its base fee
+	priorityFee, hasNeg := feeTx.GetFee().SafeSub(rgd.fmk.BaseFee(refundCtx, feeTx.GetGas()))
+	if hasNeg {
+		priorityFee = sdk.NewCoins()
+	}
+
+	ratio := rgd.ak.GetParams(refundCtx).GasRefundRatio
+	refund := authante.GasRefund(priorityFee, feeTx.GetGas(), gasUsed, ratio)
+
+	if !refund.IsZero() {
+		feePayer := feeTx.FeePayer()
+
+		err := rgd.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, authtypes.FeeCollectorName, feePayer, refund)
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "failed to refund unused gas to %s", feePayer)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				authtypes.EventTypeGasRefund,
+				sdk.NewAttribute(authtypes.AttributeKeyFeePayer, feePayer.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+			),
+		)
+	}
+
+	return next(ctx, tx, result, simulate)
+}
@@ -0,0 +1,102 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestDeductAndBurnBaseFee(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.Enabled = true
+	params.FeeDenom = sdk.DefaultBondDenom
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(5, 1))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+	app.BankKeeper.SetBalances(ctx, addr, coins)
+	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(coins))
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeMarketKeeper))
+	posthandler := ante.NewPostHandler(app.AccountKeeper, app.BankKeeper, app.FeeMarketKeeper)
+
+	newTx := func(fee int64) sdk.Tx {
+		return authtypes.NewStdTx(
+			[]sdk.Msg{testdata.NewTestMsg(addr)},
+			authtypes.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, fee))),
+			nil, "",
+		)
+	}
+
+	// the base fee of the gas limit is 50000stake
+	gasCtx := ctx.WithGasMeter(sdk.NewGasMeter(100000))
+	_, err := antehandler(gasCtx, newTx(40000), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err), err)
+
+	_, err = antehandler(gasCtx.WithIsCheckTx(true), newTx(40000), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err), err)
+
+	// the base fee is not required when simulating
+	_, err = antehandler(gasCtx, newTx(0), true)
+	require.NoError(t, err)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	supply := func() sdk.Int { return app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom) }
+
+	// nor burnt
+	simCtx, err := antehandler(gasCtx.WithEventManager(sdk.NewEventManager()), newTx(60000), true)
+	require.NoError(t, err)
+	for _, event := range simCtx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeBurnBaseFee, event.Type)
+	}
+	require.Equal(t, sdk.NewInt(100000), supply())
+
+	app.BankKeeper.SetBalances(ctx, addr, coins)
+	app.BankKeeper.SetBalances(ctx, feeCollector, nil)
+
+	// the base fee of the gas limit is burnt once the fee is deducted, whether
+	// or not the messages of the tx succeed
+	tx := newTx(60000)
+	gasCtx, err = antehandler(ctx.WithGasMeter(sdk.NewGasMeter(100000)), tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(40000), app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(10000), app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(50000), supply())
+
+	events := gasCtx.EventManager().Events()
+	require.Equal(t, types.EventTypeBurnBaseFee, events[len(events)-1].Type)
+
+	// only the priority fee of the unused gas is refunded
+	authParams := app.AccountKeeper.GetParams(ctx)
+	authParams.GasRefundRatio = sdk.NewDecWithPrec(5, 1)
+	app.AccountKeeper.SetParams(ctx, authParams)
+
+	gasCtx.GasMeter().ConsumeGas(40000-gasCtx.GasMeter().GasConsumed(), "test")
+	gasCtx, err = posthandler(gasCtx.WithEventManager(sdk.NewEventManager()), tx, &sdk.Result{}, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40000), gasCtx.GasMeter().GasConsumed())
+
+	require.Equal(t, sdk.NewInt(43000), app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(7000), app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(50000), supply())
+
+	// the refund of a simulated tx whose fee does not cover its base fee is zero
+	_, err = posthandler(gasCtx, newTx(0), &sdk.Result{}, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(7000), app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+}
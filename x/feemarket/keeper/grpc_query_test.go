@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type FeeMarketTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *FeeMarketTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+
+	suite.queryClient = queryClient
+}
+
+func (suite *FeeMarketTestSuite) TestGRPCParams() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.Params, app.FeeMarketKeeper.GetParams(ctx))
+}
+
+func (suite *FeeMarketTestSuite) TestGRPCBaseGasPrice() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 1))
+	app.FeeMarketKeeper.SetLastBlockGasUsed(ctx, 123456)
+
+	res, err := queryClient.BaseGasPrice(gocontext.Background(), &types.QueryBaseGasPriceRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 1), res.BaseGasPrice)
+	suite.Require().Equal(app.FeeMarketKeeper.GetParams(ctx).FeeDenom, res.FeeDenom)
+	suite.Require().Equal(uint64(123456), res.LastBlockGasUsed)
+}
+
+func (suite *FeeMarketTestSuite) TestBaseFee() {
+	app, ctx := suite.app, suite.ctx
+
+	// the fee market is disabled by default
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 1))
+	suite.Require().Nil(app.FeeMarketKeeper.BaseFee(ctx, 1000))
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.Enabled = true
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 2500)), app.FeeMarketKeeper.BaseFee(ctx, 1000))
+}
+
+func TestFeeMarketTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeMarketTestSuite))
+}
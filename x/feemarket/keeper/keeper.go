@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the fee market store
+type Keeper struct {
+	cdc              codec.BinaryMarshaler
+	storeKey         sdk.StoreKey
+	paramSpace       paramtypes.Subspace
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new fee market Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, feeCollectorName string,
+) Keeper {
+	// ensure fee market module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the fee market module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of fee market parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of fee market parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseGasPrice returns the base gas price of the current block.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseGasPriceKey)
+	if bz == nil {
+		panic("stored base gas price should not have been nil")
+	}
+
+	var price sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+
+	return price.Dec
+}
+
+// SetBaseGasPrice sets the base gas price of the current block.
+func (k Keeper) SetBaseGasPrice(ctx sdk.Context, price sdk.Dec) {
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: price})
+	ctx.KVStore(k.storeKey).Set(types.BaseGasPriceKey, bz)
+}
+
+// GetLastBlockGasUsed returns the gas used by the previous block.
+func (k Keeper) GetLastBlockGasUsed(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastBlockGasUsedKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastBlockGasUsed sets the gas used by the previous block.
+func (k Keeper) SetLastBlockGasUsed(ctx sdk.Context, gasUsed uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, gasUsed)
+	ctx.KVStore(k.storeKey).Set(types.LastBlockGasUsedKey, bz)
+}
+
+// BaseFee returns the base fee of the given gas at the base gas price of the
+// current block, or nil if the fee market is disabled.
+func (k Keeper) BaseFee(ctx sdk.Context, gas uint64) sdk.Coins {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return nil
+	}
+
+	return types.BaseFee(params, k.GetBaseGasPrice(ctx), gas)
+}
+
+// BurnBaseFee burns the given base fee from the fee collector.
+func (k Keeper) BurnBaseFee(ctx sdk.Context, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fee); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+}
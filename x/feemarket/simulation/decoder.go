@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding fee market type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
+	return func(kvA, kvB tmkv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.BaseGasPriceKey):
+			var priceA, priceB sdk.DecProto
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &priceB)
+			return fmt.Sprintf("%v\n%v", priceA.Dec, priceB.Dec)
+
+		case bytes.Equal(kvA.Key, types.LastBlockGasUsedKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid fee market key %X", kvA.Key))
+		}
+	}
+}
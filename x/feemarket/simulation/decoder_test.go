@@ -0,0 +1,51 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	baseGasPrice := sdk.NewDecWithPrec(15, 3)
+	gasUsed := make([]byte, 8)
+	binary.BigEndian.PutUint64(gasUsed, 123456)
+
+	kvPairs := tmkv.Pairs{
+		tmkv.Pair{Key: types.BaseGasPriceKey, Value: cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: baseGasPrice})},
+		tmkv.Pair{Key: types.LastBlockGasUsedKey, Value: gasUsed},
+		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"BaseGasPrice", fmt.Sprintf("%v\n%v", baseGasPrice, baseGasPrice)},
+		{"LastBlockGasUsed", "123456\n123456"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	MinBaseGasPrice               = "min_base_gas_price"
+	BaseGasPriceChangeDenominator = "base_gas_price_change_denominator"
+	ElasticityMultiplier          = "elasticity_multiplier"
+)
+
+// GenMinBaseGasPrice randomized MinBaseGasPrice
+func GenMinBaseGasPrice(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(99)+1), 3)
+}
+
+// GenBaseGasPriceChangeDenominator randomized BaseGasPriceChangeDenominator
+func GenBaseGasPriceChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(r.Intn(15) + 2)
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier
+func GenElasticityMultiplier(r *rand.Rand) uint32 {
+	return uint32(r.Intn(3) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for the fee market. The
+// fee market is left disabled, as the simulated txs pay random fees.
+func RandomizedGenState(simState *module.SimulationState) {
+	var minBaseGasPrice sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBaseGasPrice, &minBaseGasPrice, simState.Rand,
+		func(r *rand.Rand) { minBaseGasPrice = GenMinBaseGasPrice(r) },
+	)
+
+	var changeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseGasPriceChangeDenominator, &changeDenominator, simState.Rand,
+		func(r *rand.Rand) { changeDenominator = GenBaseGasPriceChangeDenominator(r) },
+	)
+
+	var elasticityMultiplier uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ElasticityMultiplier, &elasticityMultiplier, simState.Rand,
+		func(r *rand.Rand) { elasticityMultiplier = GenElasticityMultiplier(r) },
+	)
+
+	params := types.NewParams(false, sdk.DefaultBondDenom, minBaseGasPrice, changeDenominator, elasticityMultiplier)
+	feeMarketGenesis := types.NewGenesisState(params, minBaseGasPrice)
+
+	fmt.Printf("Selected randomly generated fee market parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, feeMarketGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feeMarketGenesis)
+}
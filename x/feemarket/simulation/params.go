@@ -0,0 +1,41 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+const (
+	keyMinBaseGasPrice               = "MinBaseGasPrice"
+	keyBaseGasPriceChangeDenominator = "BaseGasPriceChangeDenominator"
+	keyElasticityMultiplier          = "ElasticityMultiplier"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyMinBaseGasPrice,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMinBaseGasPrice(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyBaseGasPriceChangeDenominator,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenBaseGasPriceChangeDenominator(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyElasticityMultiplier,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenElasticityMultiplier(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseGasPrice returns the base gas price of the next block given the one
+// of a block and the gas it used, as in EIP-1559. The gas targeted by the base
+// gas price is the maximum gas of a block divided by the elasticity multiplier.
+// The base gas price increases when the gas used exceeds the target and
+// decreases when it falls short of it, by the relative deviation from the
+// target divided by BaseGasPriceChangeDenominator, so by at most 1/8 with the
+// default parameters. It never falls below the min base gas price, and is left
+// unchanged if the block gas is unlimited, i.e. maxBlockGas is zero.
+func NextBaseGasPrice(params Params, baseGasPrice sdk.Dec, gasUsed, maxBlockGas uint64) sdk.Dec {
+	target := maxBlockGas / uint64(params.ElasticityMultiplier)
+	if target == 0 || gasUsed == target {
+		return sdk.MaxDec(baseGasPrice, params.MinBaseGasPrice)
+	}
+
+	var delta uint64
+	if gasUsed > target {
+		delta = gasUsed - target
+	} else {
+		delta = target - gasUsed
+	}
+
+	change := baseGasPrice.
+		MulInt(sdk.NewIntFromUint64(delta)).
+		QuoInt(sdk.NewIntFromUint64(target)).
+		QuoInt64(int64(params.BaseGasPriceChangeDenominator))
+
+	next := baseGasPrice.Add(change)
+	if gasUsed < target {
+		next = baseGasPrice.Sub(change)
+	}
+
+	return sdk.MaxDec(next, params.MinBaseGasPrice)
+}
+
+// BaseFee returns the base fee of the given gas at the given base gas price,
+// rounded up.
+func BaseFee(params Params, baseGasPrice sdk.Dec, gas uint64) sdk.Coins {
+	amount := baseGasPrice.MulInt(sdk.NewIntFromUint64(gas)).Ceil().RoundInt()
+	return sdk.NewCoins(sdk.NewCoin(params.FeeDenom, amount))
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseGasPrice(t *testing.T) {
+	params := types.DefaultParams()
+	one := sdk.OneDec()
+
+	testCases := []struct {
+		name         string
+		baseGasPrice sdk.Dec
+		gasUsed      uint64
+		maxBlockGas  uint64
+		expected     sdk.Dec
+	}{
+		{"gas used on target", one, 500000, 1000000, one},
+		{"full block", one, 1000000, 1000000, sdk.NewDecWithPrec(1125, 3)},
+		{"empty block", one, 0, 1000000, sdk.NewDecWithPrec(875, 3)},
+		{"half above target", one, 750000, 1000000, sdk.NewDecWithPrec(10625, 4)},
+		{"quarter below target", one, 375000, 1000000, sdk.NewDecWithPrec(96875, 5)},
+		{"unlimited block gas", one, 1000000, 0, one},
+		{"floored to the min", params.MinBaseGasPrice, 0, 1000000, params.MinBaseGasPrice},
+		{"raised to the min", sdk.NewDecWithPrec(1, 4), 500000, 1000000, params.MinBaseGasPrice},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			next := types.NextBaseGasPrice(params, tc.baseGasPrice, tc.gasUsed, tc.maxBlockGas)
+			require.Equal(t, tc.expected, next)
+		})
+	}
+}
+
+func TestBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 2)),
+		types.BaseFee(params, sdk.NewDecWithPrec(15, 4), 1001),
+	)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 150)),
+		types.BaseFee(params, sdk.NewDecWithPrec(15, 4), 100000),
+	)
+	require.True(t, types.BaseFee(params, sdk.OneDec(), 0).IsZero())
+}
@@ -0,0 +1,10 @@
+package types
+
+// fee market module event types
+const (
+	EventTypeBaseGasPrice = "base_gas_price"
+	EventTypeBurnBaseFee  = "burn_base_fee"
+
+	AttributeKeyBaseGasPrice = "base_gas_price"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+)
@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(types.DefaultGenesisState()))
+
+	params := types.DefaultParams()
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(params, sdk.NewDecWithPrec(1, 4))))
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(params, sdk.Dec{})))
+
+	testCases := []struct {
+		name   string
+		params types.Params
+	}{
+		{"invalid fee denom", types.NewParams(true, "", params.MinBaseGasPrice, 8, 2)},
+		{"zero min base gas price", types.NewParams(true, "stake", sdk.ZeroDec(), 8, 2)},
+		{"zero change denominator", types.NewParams(true, "stake", params.MinBaseGasPrice, 0, 2)},
+		{"zero elasticity multiplier", types.NewParams(true, "stake", params.MinBaseGasPrice, 8, 0)},
+	}
+
+	for _, tc := range testCases {
+		require.Error(t, types.ValidateGenesis(types.NewGenesisState(tc.params, sdk.OneDec())), tc.name)
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// BaseGasPriceKey is the key of the base gas price of the current block
+	BaseGasPriceKey = []byte{0x00}
+
+	// LastBlockGasUsedKey is the key of the gas used by the previous block
+	LastBlockGasUsedKey = []byte{0x01}
+)
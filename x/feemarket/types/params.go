@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultBaseGasPriceChangeDenominator uint32 = 8
+	DefaultElasticityMultiplier          uint32 = 2
+)
+
+// DefaultMinBaseGasPrice is the default floor of the base gas price.
+var DefaultMinBaseGasPrice = sdk.NewDecWithPrec(1, 3)
+
+// Parameter keys
+var (
+	KeyEnabled                       = []byte("Enabled")
+	KeyFeeDenom                      = []byte("FeeDenom")
+	KeyMinBaseGasPrice               = []byte("MinBaseGasPrice")
+	KeyBaseGasPriceChangeDenominator = []byte("BaseGasPriceChangeDenominator")
+	KeyElasticityMultiplier          = []byte("ElasticityMultiplier")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// NewParams creates a new Params object
+func NewParams(
+	enabled bool, feeDenom string, minBaseGasPrice sdk.Dec,
+	baseGasPriceChangeDenominator, elasticityMultiplier uint32,
+) Params {
+	return Params{
+		Enabled:                       enabled,
+		FeeDenom:                      feeDenom,
+		MinBaseGasPrice:               minBaseGasPrice,
+		BaseGasPriceChangeDenominator: baseGasPriceChangeDenominator,
+		ElasticityMultiplier:          elasticityMultiplier,
+	}
+}
+
+// DefaultParams returns default parameters, which leave the fee market
+// disabled.
+func DefaultParams() Params {
+	return NewParams(
+		false, sdk.DefaultBondDenom, DefaultMinBaseGasPrice,
+		DefaultBaseGasPriceChangeDenominator, DefaultElasticityMultiplier,
+	)
+}
+
+// ParamKeyTable returns the parameter key table of the fee market module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of the fee market module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseGasPrice, &p.MinBaseGasPrice, validateMinBaseGasPrice),
+		paramtypes.NewParamSetPair(KeyBaseGasPriceChangeDenominator, &p.BaseGasPriceChangeDenominator, validateBaseGasPriceChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseGasPrice(p.MinBaseGasPrice); err != nil {
+		return err
+	}
+	if err := validateBaseGasPriceChangeDenominator(p.BaseGasPriceChangeDenominator); err != nil {
+		return err
+	}
+
+	return validateElasticityMultiplier(p.ElasticityMultiplier)
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseGasPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("min base gas price must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseGasPriceChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base gas price change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("elasticity multiplier must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// Schedule flags
+const (
+	FlagStartHeight    = "start-height"
+	FlagStartTime      = "start-time"
+	FlagHeightInterval = "height-interval"
+	FlagTimeInterval   = "time-interval"
+	FlagExecutions     = "executions"
+	FlagExecutionFee   = "execution-fee"
+	FlagExecutionGas   = "execution-gas"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/scheduler transaction
+// commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Scheduler transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewScheduleTxCmd(),
+		NewCancelScheduleTxCmd(),
+	)
+
+	return txCmd
+}
+
+// NewScheduleTxCmd returns a CLI command handler for creating a MsgSchedule
+// transaction.
+func NewScheduleTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [file_path]",
+		Short: "Schedule the execution of messages at a future height or time",
+		Long: strings.TrimSpace(`Schedule the execution of the messages defined in [file_path] at a future
+height or time, once or recurring. The messages are executed at the beginning of
+the block on behalf of the signer, who must be their only signer.
+
+The file holds the messages in the format of the tx submit command. The fee of
+each execution is paid to the validators, the fee of all the executions being
+held in escrow until they happen or the schedule is cancelled.
+
+Example:
+
+$ <appcli> tx scheduler schedule ./msgs.json --start-time 2021-01-01T00:00:00Z \
+    --time-interval 720h --executions 12 --execution-fee 10stake --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msgs, err := authclient.ReadMsgsFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			startHeight, _ := cmd.Flags().GetInt64(FlagStartHeight)
+			heightInterval, _ := cmd.Flags().GetInt64(FlagHeightInterval)
+			timeInterval, _ := cmd.Flags().GetDuration(FlagTimeInterval)
+			executions, _ := cmd.Flags().GetUint64(FlagExecutions)
+			gasLimit, _ := cmd.Flags().GetUint64(FlagExecutionGas)
+
+			var startTime *time.Time
+			if startTimeStr, _ := cmd.Flags().GetString(FlagStartTime); startTimeStr != "" {
+				t, err := time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return fmt.Errorf("invalid start time: %w", err)
+				}
+				startTime = &t
+			}
+
+			feeStr, _ := cmd.Flags().GetString(FlagExecutionFee)
+			fee, err := sdk.ParseCoins(feeStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSchedule(
+				clientCtx.GetFromAddress(), msgs, startHeight, startTime, heightInterval, timeInterval,
+				executions, fee, gasLimit,
+			)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "Height of the first execution")
+	cmd.Flags().String(FlagStartTime, "", "Block time from which the first execution happens, in RFC3339 format")
+	cmd.Flags().Int64(FlagHeightInterval, 0, "Number of blocks between two executions of a recurring height based schedule")
+	cmd.Flags().Duration(FlagTimeInterval, 0, "Duration between two executions of a recurring time based schedule")
+	cmd.Flags().Uint64(FlagExecutions, 1, "Number of executions")
+	cmd.Flags().String(FlagExecutionFee, "", "Fee paid to the validators on each execution, covering its gas limit at the minimum gas prices of the scheduler")
+	cmd.Flags().Uint64(FlagExecutionGas, flags.DefaultGasLimit, "Gas limit of each execution")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelScheduleTxCmd returns a CLI command handler for creating a
+// MsgCancelSchedule transaction.
+func NewCancelScheduleTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [schedule-id]",
+		Short: "Cancel a schedule, refunding the fee of its remaining executions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("schedule-id %s not a valid uint, please input a valid schedule-id", args[0])
+			}
+
+			msg := types.NewMsgCancelSchedule(clientCtx.GetFromAddress(), scheduleID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
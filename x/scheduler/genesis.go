@@ -0,0 +1,44 @@
+package scheduler
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// InitGenesis initializes the scheduler module's state from a provided genesis
+// state, checking that the module account holds the escrowed fees.
+func InitGenesis(ctx sdk.Context, bk types.BankKeeper, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetNextScheduleID(ctx, data.NextScheduleID)
+
+	var escrow sdk.Coins
+	for _, schedule := range data.Schedules {
+		k.SetSchedule(ctx, schedule)
+		k.InsertScheduleQueue(ctx, schedule)
+		escrow = escrow.Add(schedule.Escrow()...)
+	}
+
+	// check if the module account exists
+	moduleAcc := k.GetSchedulerAccount(ctx)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	balances := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
+	if !balances.IsEqual(escrow) {
+		panic(fmt.Sprintf("expected %s module account to hold %s, got %s", types.ModuleName, escrow, balances))
+	}
+}
+
+// ExportGenesis returns the scheduler module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	nextScheduleID, err := k.GetNextScheduleID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), nextScheduleID, k.GetSchedules(ctx))
+}
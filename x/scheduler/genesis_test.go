@@ -0,0 +1,53 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+func TestInitAndExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+
+	require.Equal(t, types.DefaultGenesisState(), scheduler.ExportGenesis(ctx, app.SchedulerKeeper))
+
+	send := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	msg, err := types.NewMsgSchedule(
+		addrs[0], []sdk.Msg{send}, 10, nil, 5, 0, 3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), 100000,
+	)
+	require.NoError(t, err)
+	scheduleID, err := app.SchedulerKeeper.CreateSchedule(ctx, msg)
+	require.NoError(t, err)
+
+	genState := scheduler.ExportGenesis(ctx, app.SchedulerKeeper)
+	require.NoError(t, types.ValidateGenesis(genState))
+	require.Equal(t, scheduleID+1, genState.NextScheduleID)
+	require.Len(t, genState.Schedules, 1)
+
+	// importing the exported state into a fresh store restores the queues
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, abci.Header{Height: 1})
+	moduleAddr := app2.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.NoError(t, app2.BankKeeper.SetBalances(ctx2, moduleAddr, genState.Schedules[0].Escrow()))
+
+	scheduler.InitGenesis(ctx2, app2.BankKeeper, app2.SchedulerKeeper, genState)
+	require.Equal(t, []uint64{scheduleID}, app2.SchedulerKeeper.GetDueScheduleIDs(ctx2, 10, ctx2.BlockTime(), 10))
+	require.Equal(t,
+		app.AppCodec().MustMarshalJSON(&genState),
+		app2.AppCodec().MustMarshalJSON(scheduler.ExportGenesis(ctx2, app2.SchedulerKeeper)),
+	)
+
+	// the module account must hold the escrowed fees
+	app3 := simapp.Setup(false)
+	ctx3 := app3.BaseApp.NewContext(false, abci.Header{Height: 1})
+	require.Panics(t, func() { scheduler.InitGenesis(ctx3, app3.BankKeeper, app3.SchedulerKeeper, genState) })
+}
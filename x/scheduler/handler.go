@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// NewHandler returns a handler for the scheduler messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgSchedule:
+			return handleMsgSchedule(ctx, k, msg)
+
+		case *types.MsgCancelSchedule:
+			return handleMsgCancelSchedule(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgSchedule(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSchedule) (*sdk.Result, error) {
+	scheduleID, err := k.CreateSchedule(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprint(scheduleID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{
+		Data:   types.GetScheduleIDBytes(scheduleID),
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+func handleMsgCancelSchedule(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCancelSchedule) (*sdk.Result, error) {
+	if err := k.CancelSchedule(ctx, msg.Owner, msg.ScheduleID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprint(msg.ScheduleID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
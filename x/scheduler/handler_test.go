@@ -0,0 +1,61 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+func hasEvent(events []abci.Event, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestHandleMsgScheduleAndCancelSchedule(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+	owner, other := addrs[0], addrs[1]
+
+	handler := scheduler.NewHandler(app.SchedulerKeeper)
+
+	send := banktypes.NewMsgSend(owner, other, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	msg, err := types.NewMsgSchedule(
+		owner, []sdk.Msg{send}, 10, nil, 0, 0, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), 100000,
+	)
+	require.NoError(t, err)
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, hasEvent(res.Events, types.EventTypeSchedule))
+
+	scheduleID := types.GetScheduleIDFromBytes(res.Data)
+	_, found := app.SchedulerKeeper.GetSchedule(ctx, scheduleID)
+	require.True(t, found)
+
+	// only the owner can cancel the schedule
+	_, err = handler(ctx, types.NewMsgCancelSchedule(other, scheduleID))
+	require.True(t, types.ErrNotScheduleOwner.Is(err))
+
+	res, err = handler(ctx, types.NewMsgCancelSchedule(owner, scheduleID))
+	require.NoError(t, err)
+	require.True(t, hasEvent(res.Events, types.EventTypeCancelSchedule))
+
+	_, found = app.SchedulerKeeper.GetSchedule(ctx, scheduleID)
+	require.False(t, found)
+
+	_, err = handler(ctx, types.NewMsgCancelSchedule(owner, scheduleID))
+	require.True(t, types.ErrUnknownSchedule.Is(err))
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// ExecuteDueSchedules executes the schedules due at the current block height
+// and time, up to the maximum number of executions per block. The schedules
+// left are executed in the next blocks.
+func (k Keeper) ExecuteDueSchedules(ctx sdk.Context) {
+	limit := int(k.GetParams(ctx).MaxExecutionsPerBlock)
+
+	for _, scheduleID := range k.GetDueScheduleIDs(ctx, ctx.BlockHeight(), ctx.BlockTime(), limit) {
+		schedule, found := k.GetSchedule(ctx, scheduleID)
+		if !found {
+			panic(fmt.Sprintf("schedule %d does not exist", scheduleID))
+		}
+
+		k.ExecuteSchedule(ctx, schedule)
+	}
+}
+
+// HasDueSchedules returns whether ExecuteDueSchedules executes any schedule at
+// the current block height and time.
+func (k Keeper) HasDueSchedules(ctx sdk.Context) bool {
+	if k.GetParams(ctx).MaxExecutionsPerBlock == 0 {
+		return false
+	}
+
+	return len(k.GetDueScheduleIDs(ctx, ctx.BlockHeight(), ctx.BlockTime(), 1)) > 0
+}
+
+// ExecuteSchedule executes the messages of a schedule on behalf of its owner,
+// pays the fee of the execution, then either moves the schedule to its next
+// execution or deletes it after the last one. The state changes of the messages
+// are discarded if any of them fails, the fee being paid nonetheless.
+func (k Keeper) ExecuteSchedule(ctx sdk.Context, schedule types.Schedule) {
+	k.RemoveScheduleQueue(ctx, schedule)
+
+	gasMeter := sdk.NewGasMeter(schedule.GasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	result := types.ExecutionResult{Height: ctx.BlockHeight(), Time: ctx.BlockTime(), Success: true}
+
+	err := k.runMsgs(cacheCtx, schedule)
+	if err == nil {
+		// re-emit the events of the messages, which are only kept on success
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+	} else {
+		result.Success = false
+		result.Log = err.Error()
+	}
+	result.GasUsed = gasMeter.GasConsumed()
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, schedule.Fee); err != nil {
+		panic(fmt.Sprintf("failed to pay the fee of schedule %d: %s", schedule.ID, err))
+	}
+
+	schedule.LastResult = &result
+	schedule.RemainingExecutions--
+	if schedule.RemainingExecutions > 0 {
+		schedule.Advance()
+		k.SetSchedule(ctx, schedule)
+		k.InsertScheduleQueue(ctx, schedule)
+	} else {
+		k.DeleteSchedule(ctx, schedule)
+	}
+
+	k.Logger(ctx).Info(
+		"schedule executed", "schedule_id", schedule.ID, "success", result.Success, "error", result.Log,
+	)
+
+	event := sdk.NewEvent(
+		types.EventTypeExecuteSchedule,
+		sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprint(schedule.ID)),
+		sdk.NewAttribute(types.AttributeKeyOwner, schedule.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeySuccess, fmt.Sprint(result.Success)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprint(result.GasUsed)),
+		sdk.NewAttribute(types.AttributeKeyRemainingExecs, fmt.Sprint(schedule.RemainingExecutions)),
+	)
+	if !result.Success {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyError, result.Log))
+	}
+	ctx.EventManager().EmitEvent(event)
+}
+
+// runMsgs dispatches the messages of a schedule to their handler, stopping at
+// the first failure. As in a transaction, running out of gas and any other panic
+// are recovered and reported as an error, so that they do not halt the chain.
+func (k Keeper) runMsgs(ctx sdk.Context, schedule types.Schedule) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(
+					sdkerrors.ErrOutOfGas, "out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					oog.Descriptor, schedule.GasLimit, ctx.GasMeter().GasConsumed(),
+				)
+				return
+			}
+
+			// the error is stored in the execution result, so the stack, which
+			// depends on the binary, is only logged
+			k.Logger(ctx).Error(
+				"recovered from panic executing schedule", "schedule_id", schedule.ID,
+				"panic", r, "stack", string(debug.Stack()),
+			)
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "recovered: %v", r)
+		}
+	}()
+
+	msgs, err := schedule.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		// like in a transaction, only the events of the result are kept
+		res, err := handler(ctx.WithEventManager(sdk.NewEventManager()), msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		msgEvents := sdk.Events{
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type())),
+		}
+		for _, event := range res.GetEvents() {
+			msgEvents = msgEvents.AppendEvent(sdk.Event(event))
+		}
+		ctx.EventManager().EmitEvents(
+			msgEvents.AppendAttributes(
+				sdk.NewAttribute(sdk.AttributeKeyMsgIndex, fmt.Sprint(i)),
+				sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprint(schedule.ID)),
+			),
+		)
+	}
+
+	return nil
+}
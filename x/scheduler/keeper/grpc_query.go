@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the scheduler module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Schedule returns a schedule by its ID.
+func (k Keeper) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule, found := k.GetSchedule(ctx, req.ScheduleID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "schedule %d doesn't exist", req.ScheduleID)
+	}
+
+	return &types.QueryScheduleResponse{Schedule: schedule}, nil
+}
+
+// Schedules returns the schedules, optionally filtered by owner.
+func (k Keeper) Schedules(c context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	// the owner index holds the IDs of the schedules, the schedules store the
+	// schedules themselves
+	schedules := []types.Schedule{}
+	var (
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.Owner.Empty() {
+		pageRes, err = query.Paginate(prefix.NewStore(store, types.SchedulesKeyPrefix), req.Pagination, func(_ []byte, value []byte) error {
+			var schedule types.Schedule
+			if err := k.cdc.UnmarshalBinaryBare(value, &schedule); err != nil {
+				return err
+			}
+
+			schedules = append(schedules, schedule)
+			return nil
+		})
+	} else {
+		pageRes, err = query.Paginate(prefix.NewStore(store, types.SchedulesByOwnerKey(req.Owner)), req.Pagination, func(_ []byte, value []byte) error {
+			schedule, found := k.GetSchedule(ctx, types.GetScheduleIDFromBytes(value))
+			if !found {
+				return status.Errorf(codes.Internal, "schedule %d doesn't exist", types.GetScheduleIDFromBytes(value))
+			}
+
+			schedules = append(schedules, schedule)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// RegisterInvariants registers all scheduler invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+}
+
+// ModuleAccountInvariant checks that the module account coins reflects the sum
+// of the fees held in escrow for the remaining executions of the schedules.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedEscrow sdk.Coins
+
+		k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+			expectedEscrow = expectedEscrow.Add(schedule.Escrow()...)
+			return false
+		})
+
+		macc := k.GetSchedulerAccount(ctx)
+		balances := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+		broken := !balances.IsEqual(expectedEscrow)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow",
+			fmt.Sprintf("\tscheduler ModuleAccount coins: %s\n\tsum of escrowed fees:          %s\n",
+				balances, expectedEscrow)), broken
+	}
+}
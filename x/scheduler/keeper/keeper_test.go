@@ -0,0 +1,413 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+var startTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+	addrs       []sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: startTime})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.SchedulerKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = queryClient
+	suite.addrs = simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+}
+
+func (suite *KeeperTestSuite) TearDownTest() {
+	msg, broken := keeper.ModuleAccountInvariant(suite.app.SchedulerKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+// newMsgSchedule returns a MsgSchedule sending amount to the second test
+// account from the first one, which owns the schedule.
+func (suite *KeeperTestSuite) newMsgSchedule(
+	amount int64, startHeight int64, startTime *time.Time, heightInterval int64, timeInterval time.Duration, executions uint64,
+) *types.MsgSchedule {
+	send := banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], sdk.NewCoins(suite.coin(amount)))
+	msg, err := types.NewMsgSchedule(
+		suite.addrs[0], []sdk.Msg{send}, startHeight, startTime, heightInterval, timeInterval,
+		executions, sdk.NewCoins(suite.coin(10)), 100000,
+	)
+	suite.Require().NoError(err)
+
+	return msg
+}
+
+func (suite *KeeperTestSuite) coin(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, sdk.DefaultBondDenom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) moduleBalance(name string) int64 {
+	return suite.balance(suite.app.AccountKeeper.GetModuleAddress(name))
+}
+
+// nextBlock moves to the given height and time and executes the due schedules.
+func (suite *KeeperTestSuite) nextBlock(height int64, t time.Time) {
+	suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+	suite.app.SchedulerKeeper.ExecuteDueSchedules(suite.ctx)
+}
+
+func (suite *KeeperTestSuite) TestCreateSchedule() {
+	app := suite.app
+
+	scheduleID, err := app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 3, nil, 2, 0, 3))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), scheduleID)
+
+	// the fee of the 3 executions is held in escrow
+	suite.Require().Equal(int64(10000-30), suite.balance(suite.addrs[0]))
+	suite.Require().Equal(int64(30), suite.moduleBalance(types.ModuleName))
+
+	schedule, found := app.SchedulerKeeper.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.addrs[0], schedule.Owner)
+	suite.Require().Equal(int64(3), schedule.NextHeight)
+	suite.Require().Equal(uint64(3), schedule.RemainingExecutions)
+	suite.Require().Nil(schedule.LastResult)
+
+	msgs, err := schedule.GetMessages()
+	suite.Require().NoError(err)
+	suite.Require().Len(msgs, 1)
+	suite.Require().IsType(&banktypes.MsgSend{}, msgs[0])
+
+	nextScheduleID, err := app.SchedulerKeeper.GetNextScheduleID(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), nextScheduleID)
+
+	past := startTime.Add(-time.Second)
+	_, err = app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 1, nil, 0, 0, 1))
+	suite.Require().True(types.ErrInvalidTrigger.Is(err), err)
+	_, err = app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 0, &past, 0, 0, 1))
+	suite.Require().True(types.ErrInvalidTrigger.Is(err), err)
+
+	msg := suite.newMsgSchedule(100, 3, nil, 0, 0, 1)
+	msg.GasLimit = types.DefaultMaxGasPerExecution + 1
+	_, err = app.SchedulerKeeper.CreateSchedule(suite.ctx, msg)
+	suite.Require().True(types.ErrInvalidGasLimit.Is(err), err)
+
+	msg = suite.newMsgSchedule(100, 3, nil, 1, 0, 1000)
+	_, err = app.SchedulerKeeper.CreateSchedule(suite.ctx, msg)
+	suite.Require().True(sdkerrors.ErrInsufficientFunds.Is(err), err)
+}
+
+func (suite *KeeperTestSuite) TestCreateScheduleParams() {
+	k := suite.app.SchedulerKeeper
+
+	params := k.GetParams(suite.ctx)
+	params.MaxExecutions = 5
+	params.MinTimeInterval = time.Minute
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 4)))
+	k.SetParams(suite.ctx, params)
+
+	// the executions of a schedule are capped
+	_, err := k.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 3, nil, 1, 0, 6))
+	suite.Require().True(types.ErrInvalidExecutions.Is(err), err)
+
+	// the executions of a recurring time based schedule are spaced out
+	start := startTime.Add(time.Hour)
+	_, err = k.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 0, &start, 0, time.Second, 2))
+	suite.Require().True(types.ErrInvalidTrigger.Is(err), err)
+
+	// the fee of an execution covers its gas limit at the minimum gas prices
+	msg := suite.newMsgSchedule(100, 3, nil, 1, 0, 5)
+	msg.GasLimit = 100001
+	_, err = k.CreateSchedule(suite.ctx, msg)
+	suite.Require().True(sdkerrors.ErrInsufficientFee.Is(err), err)
+
+	msg.Fee = sdk.NewCoins(sdk.NewInt64Coin("other", 1000))
+	msg.GasLimit = 100000
+	_, err = k.CreateSchedule(suite.ctx, msg)
+	suite.Require().True(sdkerrors.ErrInsufficientFee.Is(err), err)
+
+	_, err = k.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 3, nil, 1, 0, 5))
+	suite.Require().NoError(err)
+	_, err = k.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 0, &start, 0, time.Minute, 2))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestExecuteHeightSchedule() {
+	app := suite.app
+
+	scheduleID, err := app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 3, nil, 2, 0, 2))
+	suite.Require().NoError(err)
+
+	// nothing is due before the start height
+	suite.nextBlock(2, startTime.Add(5*time.Second))
+	suite.Require().Equal(int64(10000), suite.balance(suite.addrs[1]))
+	suite.Require().Empty(suite.ctx.EventManager().Events())
+
+	suite.nextBlock(3, startTime.Add(10*time.Second))
+	suite.Require().Equal(int64(10100), suite.balance(suite.addrs[1]))
+	suite.Require().Equal(int64(10), suite.moduleBalance(types.ModuleName))
+	suite.Require().Equal(int64(10), suite.moduleBalance(authtypes.FeeCollectorName))
+
+	schedule, found := app.SchedulerKeeper.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(5), schedule.NextHeight)
+	suite.Require().Equal(uint64(1), schedule.RemainingExecutions)
+	suite.Require().True(schedule.LastResult.Success)
+	suite.Require().Equal(int64(3), schedule.LastResult.Height)
+	suite.Require().NotZero(schedule.LastResult.GasUsed)
+
+	// the events of the message are kept along with the execution result
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeExecuteSchedule, events[len(events)-1].Type)
+	suite.Require().Contains(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyAction, banktypes.TypeMsgSend),
+		sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0"),
+		sdk.NewAttribute(types.AttributeKeyScheduleID, "1"),
+	))
+
+	suite.nextBlock(4, startTime.Add(15*time.Second))
+	suite.Require().Equal(int64(10100), suite.balance(suite.addrs[1]))
+
+	// the schedule is deleted after its last execution
+	suite.nextBlock(5, startTime.Add(20*time.Second))
+	suite.Require().Equal(int64(10200), suite.balance(suite.addrs[1]))
+	suite.Require().Equal(int64(0), suite.moduleBalance(types.ModuleName))
+	suite.Require().Equal(int64(20), suite.moduleBalance(authtypes.FeeCollectorName))
+
+	_, found = app.SchedulerKeeper.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestExecuteTimeSchedule() {
+	app := suite.app
+
+	start := startTime.Add(time.Hour)
+	scheduleID, err := app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 0, &start, 0, time.Hour, 2))
+	suite.Require().NoError(err)
+
+	suite.nextBlock(2, start.Add(-time.Second))
+	suite.Require().Equal(int64(10000), suite.balance(suite.addrs[1]))
+
+	suite.nextBlock(3, start.Add(time.Second))
+	suite.Require().Equal(int64(10100), suite.balance(suite.addrs[1]))
+
+	schedule, found := app.SchedulerKeeper.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().True(found)
+	suite.Require().Equal(start.Add(time.Hour), *schedule.NextTime)
+
+	suite.nextBlock(4, start.Add(time.Hour))
+	suite.Require().Equal(int64(10200), suite.balance(suite.addrs[1]))
+
+	_, found = app.SchedulerKeeper.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestExecuteFailedSchedule() {
+	app := suite.app
+
+	// the owner cannot afford the transfer, which is reverted while the fee of
+	// the execution is paid
+	scheduleID, err := app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(20000, 2, nil, 1, 0, 2))
+	suite.Require().NoError(err)
+
+	suite.nextBlock(2, startTime.Add(5*time.Second))
+	suite.Require().Equal(int64(10000-20), suite.balance(suite.addrs[0]))
+	suite.Require().Equal(int64(10000), suite.balance(suite.addrs[1]))
+	suite.Require().Equal(int64(10), suite.moduleBalance(authtypes.FeeCollectorName))
+
+	schedule, found := app.SchedulerKeeper.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().True(found)
+	suite.Require().False(schedule.LastResult.Success)
+	suite.Require().Contains(schedule.LastResult.Log, "insufficient funds")
+
+	// only the events of the fee payment and of the result are kept
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeExecuteSchedule, events[len(events)-1].Type)
+	suite.Require().NotContains(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyAction, banktypes.TypeMsgSend),
+		sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0"),
+		sdk.NewAttribute(types.AttributeKeyScheduleID, "1"),
+	))
+
+	// running out of gas fails the execution as well
+	msg := suite.newMsgSchedule(100, 3, nil, 1, 0, 2)
+	msg.GasLimit = 10
+	scheduleID, err = app.SchedulerKeeper.CreateSchedule(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	suite.nextBlock(3, startTime.Add(10*time.Second))
+	suite.Require().Equal(int64(10000), suite.balance(suite.addrs[1]))
+
+	schedule, found = app.SchedulerKeeper.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().True(found)
+	suite.Require().Contains(schedule.LastResult.Log, sdkerrors.ErrOutOfGas.Error())
+
+	// a panicking handler fails the execution as well, instead of halting the
+	// chain
+	router := baseapp.NewRouter().AddRoute(sdk.NewRoute(banktypes.RouterKey, func(sdk.Context, sdk.Msg) (*sdk.Result, error) {
+		panic("handler panic")
+	}))
+	k := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		app.AccountKeeper, app.BankKeeper, router, authtypes.FeeCollectorName,
+	)
+
+	scheduleID, err = k.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 4, nil, 1, 0, 2))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(15 * time.Second))
+	suite.Require().NotPanics(func() { k.ExecuteDueSchedules(suite.ctx) })
+	suite.Require().Equal(int64(10000), suite.balance(suite.addrs[1]))
+
+	schedule, found = k.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().True(found)
+	suite.Require().False(schedule.LastResult.Success)
+	suite.Require().Contains(schedule.LastResult.Log, "recovered: handler panic")
+	suite.Require().Contains(schedule.LastResult.Log, sdkerrors.ErrPanic.Error())
+}
+
+func (suite *KeeperTestSuite) TestMaxExecutionsPerBlock() {
+	app := suite.app
+
+	params := app.SchedulerKeeper.GetParams(suite.ctx)
+	params.MaxExecutionsPerBlock = 1
+	app.SchedulerKeeper.SetParams(suite.ctx, params)
+
+	start := startTime.Add(time.Second)
+	_, err := app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 0, &start, 0, 0, 1))
+	suite.Require().NoError(err)
+	_, err = app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 2, nil, 0, 0, 1))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]uint64{2, 1}, app.SchedulerKeeper.GetDueScheduleIDs(suite.ctx, 2, start, 10))
+	suite.Require().False(app.SchedulerKeeper.HasDueSchedules(suite.ctx))
+	suite.Require().True(app.SchedulerKeeper.HasDueSchedules(suite.ctx.WithBlockHeight(2)))
+
+	// the due schedules beyond the limit are postponed to the next block
+	suite.nextBlock(2, start)
+	suite.Require().Equal(int64(10100), suite.balance(suite.addrs[1]))
+	suite.Require().Len(app.SchedulerKeeper.GetSchedules(suite.ctx), 1)
+
+	suite.nextBlock(3, start.Add(time.Second))
+	suite.Require().Equal(int64(10200), suite.balance(suite.addrs[1]))
+	suite.Require().Empty(app.SchedulerKeeper.GetSchedules(suite.ctx))
+	suite.Require().False(app.SchedulerKeeper.HasDueSchedules(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestDueSchedulesShare() {
+	k := suite.app.SchedulerKeeper
+
+	start := startTime.Add(time.Second)
+	for i := 0; i < 3; i++ {
+		_, err := k.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 2, nil, 0, 0, 1))
+		suite.Require().NoError(err)
+		_, err = k.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 0, &start, 0, 0, 1))
+		suite.Require().NoError(err)
+	}
+
+	// the limit is shared evenly between the height and time based schedules
+	suite.Require().Equal([]uint64{1, 3, 2, 4}, k.GetDueScheduleIDs(suite.ctx, 2, start, 4))
+
+	// the odd share alternates between them
+	suite.Require().Equal([]uint64{1, 3, 2}, k.GetDueScheduleIDs(suite.ctx, 2, start, 3))
+	suite.Require().Equal([]uint64{1, 2, 4}, k.GetDueScheduleIDs(suite.ctx, 3, start, 3))
+	suite.Require().Equal([]uint64{1}, k.GetDueScheduleIDs(suite.ctx, 2, start, 1))
+	suite.Require().Equal([]uint64{2}, k.GetDueScheduleIDs(suite.ctx, 3, start, 1))
+
+	// the share left by either goes to the other
+	suite.Require().Equal([]uint64{1, 3, 5}, k.GetDueScheduleIDs(suite.ctx, 2, startTime, 4))
+	suite.Require().Equal([]uint64{2, 4, 6}, k.GetDueScheduleIDs(suite.ctx, 1, start, 4))
+}
+
+func (suite *KeeperTestSuite) TestCancelSchedule() {
+	app := suite.app
+
+	scheduleID, err := app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 2, nil, 1, 0, 3))
+	suite.Require().NoError(err)
+
+	suite.nextBlock(2, startTime.Add(5*time.Second))
+	suite.Require().Equal(int64(10000-30-100), suite.balance(suite.addrs[0]))
+
+	err = app.SchedulerKeeper.CancelSchedule(suite.ctx, suite.addrs[1], scheduleID)
+	suite.Require().True(types.ErrNotScheduleOwner.Is(err), err)
+	err = app.SchedulerKeeper.CancelSchedule(suite.ctx, suite.addrs[0], 42)
+	suite.Require().True(types.ErrUnknownSchedule.Is(err), err)
+
+	// the fee of the 2 remaining executions is refunded
+	suite.Require().NoError(app.SchedulerKeeper.CancelSchedule(suite.ctx, suite.addrs[0], scheduleID))
+	suite.Require().Equal(int64(10000-10-100), suite.balance(suite.addrs[0]))
+	suite.Require().Equal(int64(0), suite.moduleBalance(types.ModuleName))
+
+	_, found := app.SchedulerKeeper.GetSchedule(suite.ctx, scheduleID)
+	suite.Require().False(found)
+
+	suite.nextBlock(3, startTime.Add(10*time.Second))
+	suite.Require().Equal(int64(10100), suite.balance(suite.addrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySchedules() {
+	app, queryClient := suite.app, suite.queryClient
+
+	scheduleID, err := app.SchedulerKeeper.CreateSchedule(suite.ctx, suite.newMsgSchedule(100, 2, nil, 0, 0, 1))
+	suite.Require().NoError(err)
+
+	res, err := queryClient.Schedule(gocontext.Background(), &types.QueryScheduleRequest{ScheduleID: scheduleID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(scheduleID, res.Schedule.ID)
+	msgs, err := res.Schedule.GetMessages()
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[1], msgs[0].(*banktypes.MsgSend).ToAddress)
+
+	_, err = queryClient.Schedule(gocontext.Background(), &types.QueryScheduleRequest{ScheduleID: 42})
+	suite.Require().Error(err)
+
+	allRes, err := queryClient.Schedules(gocontext.Background(), &types.QuerySchedulesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.Schedules, 1)
+
+	ownerRes, err := queryClient.Schedules(gocontext.Background(), &types.QuerySchedulesRequest{Owner: suite.addrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Len(ownerRes.Schedules, 1)
+	suite.Require().Equal(scheduleID, ownerRes.Schedules[0].ID)
+
+	ownerRes, err = queryClient.Schedules(gocontext.Background(), &types.QuerySchedulesRequest{Owner: suite.addrs[1]})
+	suite.Require().NoError(err)
+	suite.Require().Empty(ownerRes.Schedules)
+
+	paramsRes, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), paramsRes.Params)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// CreateSchedule validates the messages of a MsgSchedule against the state,
+// escrows the fee of all its executions and stores the new schedule. It returns
+// the ID of the schedule.
+func (k Keeper) CreateSchedule(ctx sdk.Context, msg *types.MsgSchedule) (uint64, error) {
+	if msg.StartHeight > 0 && msg.StartHeight <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidTrigger, "start height %d is not in the future", msg.StartHeight)
+	}
+	if msg.StartTime != nil && !msg.StartTime.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidTrigger, "start time %s is not in the future", msg.StartTime)
+	}
+
+	params := k.GetParams(ctx)
+	if msg.GasLimit > params.MaxGasPerExecution {
+		return 0, sdkerrors.Wrapf(
+			types.ErrInvalidGasLimit, "gas limit %d exceeds the maximum %d", msg.GasLimit, params.MaxGasPerExecution,
+		)
+	}
+	if msg.Executions > params.MaxExecutions {
+		return 0, sdkerrors.Wrapf(
+			types.ErrInvalidExecutions, "%d executions exceed the maximum %d", msg.Executions, params.MaxExecutions,
+		)
+	}
+	if msg.TimeInterval > 0 && msg.TimeInterval < params.MinTimeInterval {
+		return 0, sdkerrors.Wrapf(
+			types.ErrInvalidTrigger, "time interval %s is below the minimum %s", msg.TimeInterval, params.MinTimeInterval,
+		)
+	}
+
+	// the fee of an execution must cover its gas limit in one of the
+	// denominations of the minimum gas prices
+	if minFee := params.MinFee(msg.GasLimit); !minFee.Empty() && !msg.Fee.IsAnyGTE(minFee) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "fee %s is below the minimum %s", msg.Fee, minFee)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return 0, err
+	}
+	for i, m := range msgs {
+		if k.router.Route(ctx, m.Route()) == nil {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", m.Route(), i)
+		}
+	}
+
+	scheduleID, err := k.GetNextScheduleID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	schedule := types.NewSchedule(scheduleID, msg)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, schedule.Owner, types.ModuleName, schedule.Escrow()); err != nil {
+		return 0, err
+	}
+
+	k.SetSchedule(ctx, schedule)
+	k.InsertScheduleQueue(ctx, schedule)
+	k.SetNextScheduleID(ctx, scheduleID+1)
+
+	return scheduleID, nil
+}
+
+// CancelSchedule deletes a schedule of the given owner and refunds the fee of
+// its remaining executions.
+func (k Keeper) CancelSchedule(ctx sdk.Context, owner sdk.AccAddress, scheduleID uint64) error {
+	schedule, found := k.GetSchedule(ctx, scheduleID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSchedule, "%d", scheduleID)
+	}
+	if !schedule.Owner.Equals(owner) {
+		return sdkerrors.Wrapf(types.ErrNotScheduleOwner, "%s", owner)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, schedule.Owner, schedule.Escrow()); err != nil {
+		return err
+	}
+
+	k.RemoveScheduleQueue(ctx, schedule)
+	k.DeleteSchedule(ctx, schedule)
+
+	return nil
+}
+
+// GetSchedule returns the schedule of the given ID.
+func (k Keeper) GetSchedule(ctx sdk.Context, scheduleID uint64) (types.Schedule, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ScheduleKey(scheduleID))
+	if bz == nil {
+		return types.Schedule{}, false
+	}
+
+	var schedule types.Schedule
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+
+	return schedule, true
+}
+
+// SetSchedule stores a schedule and indexes it by owner.
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScheduleKey(schedule.ID), k.cdc.MustMarshalBinaryBare(&schedule))
+	store.Set(types.ScheduleByOwnerKey(schedule.Owner, schedule.ID), types.GetScheduleIDBytes(schedule.ID))
+}
+
+// DeleteSchedule deletes a schedule and its owner index entry.
+func (k Keeper) DeleteSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScheduleKey(schedule.ID))
+	store.Delete(types.ScheduleByOwnerKey(schedule.Owner, schedule.ID))
+}
+
+// IterateSchedules iterates over all the schedules, by ID, until the callback
+// returns true.
+func (k Keeper) IterateSchedules(ctx sdk.Context, cb func(schedule types.Schedule) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SchedulesKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &schedule)
+
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// GetSchedules returns all the schedules.
+func (k Keeper) GetSchedules(ctx sdk.Context) []types.Schedule {
+	schedules := []types.Schedule{}
+	k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	return schedules
+}
+
+// GetNextScheduleID returns the ID of the next schedule created.
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) (uint64, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextScheduleIDKey)
+	if bz == nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalidGenesis, "initial schedule ID hasn't been set")
+	}
+
+	return types.GetScheduleIDFromBytes(bz), nil
+}
+
+// SetNextScheduleID sets the ID of the next schedule created.
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, scheduleID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextScheduleIDKey, types.GetScheduleIDBytes(scheduleID))
+}
+
+// GetDueScheduleIDs returns the IDs of at most limit schedules due at the given
+// height and time, height based schedules first, each by due height or time.
+// The limit is shared evenly between the height and time based schedules, the
+// odd share going to the time based ones at odd heights, and the share left by
+// either going to the other, so that neither starves the other.
+func (k Keeper) GetDueScheduleIDs(ctx sdk.Context, height int64, t time.Time, limit int) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	collect := func(start, end []byte) []uint64 {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
+
+		scheduleIDs := make([]uint64, 0)
+		for ; iterator.Valid() && len(scheduleIDs) < limit; iterator.Next() {
+			scheduleIDs = append(scheduleIDs, types.GetScheduleIDFromBytes(iterator.Value()))
+		}
+
+		return scheduleIDs
+	}
+
+	heightIDs := collect(types.HeightQueuePrefix, sdk.PrefixEndBytes(types.HeightQueueByHeightKey(height)))
+	timeIDs := collect(types.TimeQueuePrefix, sdk.PrefixEndBytes(types.TimeQueueByTimeKey(t)))
+
+	timeShare := limit / 2
+	if limit%2 == 1 && height%2 == 1 {
+		timeShare++
+	}
+	if len(timeIDs) < timeShare {
+		timeShare = len(timeIDs)
+	}
+	if len(heightIDs) > limit-timeShare {
+		heightIDs = heightIDs[:limit-timeShare]
+	}
+	if len(timeIDs) > limit-len(heightIDs) {
+		timeIDs = timeIDs[:limit-len(heightIDs)]
+	}
+
+	return append(heightIDs, timeIDs...)
+}
+
+// InsertScheduleQueue inserts a schedule in the queue of its next execution.
+func (k Keeper) InsertScheduleQueue(ctx sdk.Context, schedule types.Schedule) {
+	ctx.KVStore(k.storeKey).Set(scheduleQueueKey(schedule), types.GetScheduleIDBytes(schedule.ID))
+}
+
+// RemoveScheduleQueue removes a schedule from the queue of its next execution.
+func (k Keeper) RemoveScheduleQueue(ctx sdk.Context, schedule types.Schedule) {
+	ctx.KVStore(k.storeKey).Delete(scheduleQueueKey(schedule))
+}
+
+func scheduleQueueKey(schedule types.Schedule) []byte {
+	switch {
+	case schedule.IsHeightBased():
+		return types.HeightQueueKey(schedule.ID, schedule.NextHeight)
+
+	case schedule.NextTime != nil:
+		return types.TimeQueueKey(schedule.ID, *schedule.NextTime)
+
+	default:
+		panic(fmt.Sprintf("schedule %d has no trigger", schedule.ID))
+	}
+}
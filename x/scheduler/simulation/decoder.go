@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// NewDecodeStore returns a decoder function closure that decodes the KVPair of
+// the scheduler store.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
+	return func(kvA, kvB tmkv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.SchedulesKeyPrefix):
+			var scheduleA, scheduleB types.Schedule
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &scheduleA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.HasPrefix(kvA.Key, types.HeightQueuePrefix),
+			bytes.HasPrefix(kvA.Key, types.TimeQueuePrefix),
+			bytes.HasPrefix(kvA.Key, types.SchedulesByOwnerPrefix),
+			bytes.Equal(kvA.Key, types.NextScheduleIDKey):
+			return fmt.Sprintf("%d\n%d", types.GetScheduleIDFromBytes(kvA.Value), types.GetScheduleIDFromBytes(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid scheduler key %X", kvA.Key))
+		}
+	}
+}
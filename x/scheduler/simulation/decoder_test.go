@@ -0,0 +1,59 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/simulation"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+var (
+	owner = sdk.AccAddress([]byte("owner_______________"))
+	other = sdk.AccAddress([]byte("other_______________"))
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	send := banktypes.NewMsgSend(owner, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	msg, err := types.NewMsgSchedule(owner, []sdk.Msg{send}, 10, nil, 0, 0, 1, nil, 100000)
+	require.NoError(t, err)
+	schedule := types.NewSchedule(1, msg)
+
+	kvPairs := tmkv.Pairs{
+		tmkv.Pair{Key: types.ScheduleKey(1), Value: cdc.MustMarshalBinaryBare(&schedule)},
+		tmkv.Pair{Key: types.HeightQueueKey(1, 10), Value: types.GetScheduleIDBytes(1)},
+		tmkv.Pair{Key: types.NextScheduleIDKey, Value: types.GetScheduleIDBytes(2)},
+		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Schedule", fmt.Sprintf("%v\n%v", schedule, schedule)},
+		{"HeightQueue", "1\n1"},
+		{"NextScheduleID", "2\n2"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// Simulation parameter constants
+const (
+	MaxExecutionsPerBlock = "max_executions_per_block"
+	MaxGasPerExecution    = "max_gas_per_execution"
+	MaxExecutions         = "max_executions"
+	MinTimeInterval       = "min_time_interval"
+	MinGasPrices          = "min_gas_prices"
+)
+
+// GenMaxExecutionsPerBlock randomized MaxExecutionsPerBlock
+func GenMaxExecutionsPerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(200) + 1)
+}
+
+// GenMaxGasPerExecution randomized MaxGasPerExecution
+func GenMaxGasPerExecution(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000000) + 100000)
+}
+
+// GenMaxExecutions randomized MaxExecutions
+func GenMaxExecutions(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10000) + 1)
+}
+
+// GenMinTimeInterval randomized MinTimeInterval
+func GenMinTimeInterval(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(3600)+1) * time.Second
+}
+
+// GenMinGasPrices randomized MinGasPrices
+func GenMinGasPrices(r *rand.Rand) sdk.DecCoins {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(int64(r.Intn(1000)+1), 6)))
+}
+
+// RandomizedGenState generates a random GenesisState for the scheduler, in which
+// there is no schedule.
+func RandomizedGenState(simState *module.SimulationState) {
+	var maxExecutionsPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxExecutionsPerBlock, &maxExecutionsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxExecutionsPerBlock = GenMaxExecutionsPerBlock(r) },
+	)
+
+	var maxGasPerExecution uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxGasPerExecution, &maxGasPerExecution, simState.Rand,
+		func(r *rand.Rand) { maxGasPerExecution = GenMaxGasPerExecution(r) },
+	)
+
+	var maxExecutions uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxExecutions, &maxExecutions, simState.Rand,
+		func(r *rand.Rand) { maxExecutions = GenMaxExecutions(r) },
+	)
+
+	var minTimeInterval time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinTimeInterval, &minTimeInterval, simState.Rand,
+		func(r *rand.Rand) { minTimeInterval = GenMinTimeInterval(r) },
+	)
+
+	var minGasPrices sdk.DecCoins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinGasPrices, &minGasPrices, simState.Rand,
+		func(r *rand.Rand) { minGasPrices = GenMinGasPrices(r) },
+	)
+
+	params := types.NewParams(maxExecutionsPerBlock, maxGasPerExecution, maxExecutions, minTimeInterval, minGasPrices)
+	schedulerGenesis := types.NewGenesisState(params, 1, []types.Schedule{})
+
+	fmt.Printf("Selected randomly generated scheduler parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, schedulerGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(schedulerGenesis)
+}
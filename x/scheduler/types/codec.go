@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the necessary x/scheduler interfaces and concrete
+// types on the provided Amino codec. These types are used for Amino JSON
+// serialization.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgSchedule{}, "cosmos-sdk/MsgSchedule", nil)
+	cdc.RegisterConcrete(&MsgCancelSchedule{}, "cosmos-sdk/MsgCancelSchedule", nil)
+}
+
+// RegisterInterfaces registers the x/scheduler messages on the provided
+// InterfaceRegistry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSchedule{},
+		&MsgCancelSchedule{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/scheduler module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/scheduler
+	// and defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/scheduler module sentinel errors
+var (
+	ErrInvalidMsgs       = sdkerrors.Register(ModuleName, 2, "invalid scheduled messages")
+	ErrInvalidTrigger    = sdkerrors.Register(ModuleName, 3, "invalid schedule trigger")
+	ErrInvalidExecutions = sdkerrors.Register(ModuleName, 4, "invalid number of executions")
+	ErrInvalidGasLimit   = sdkerrors.Register(ModuleName, 5, "invalid execution gas limit")
+	ErrUnknownSchedule   = sdkerrors.Register(ModuleName, 6, "unknown schedule")
+	ErrNotScheduleOwner  = sdkerrors.Register(ModuleName, 7, "account is not the owner of the schedule")
+	ErrInvalidGenesis    = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
+)
@@ -0,0 +1,16 @@
+package types
+
+// scheduler module event types
+const (
+	EventTypeSchedule        = "schedule"
+	EventTypeCancelSchedule  = "cancel_schedule"
+	EventTypeExecuteSchedule = "execute_schedule"
+
+	AttributeValueCategory     = ModuleName
+	AttributeKeyScheduleID     = "schedule_id"
+	AttributeKeyOwner          = "owner"
+	AttributeKeySuccess        = "success"
+	AttributeKeyError          = "error"
+	AttributeKeyGasUsed        = "gas_used"
+	AttributeKeyRemainingExecs = "remaining_executions"
+)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, nextScheduleID uint64, schedules []Schedule) GenesisState {
+	return GenesisState{
+		Params:         params,
+		NextScheduleID: nextScheduleID,
+		Schedules:      schedules,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object, in which there is
+// no schedule.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), 1, []Schedule{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.NextScheduleID == 0 {
+		return fmt.Errorf("next schedule ID must be positive")
+	}
+
+	seen := make(map[uint64]bool, len(data.Schedules))
+	for _, schedule := range data.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("invalid schedule %d: %w", schedule.ID, err)
+		}
+		if schedule.ID >= data.NextScheduleID {
+			return fmt.Errorf("schedule ID %d is not lower than the next schedule ID %d", schedule.ID, data.NextScheduleID)
+		}
+		if seen[schedule.ID] {
+			return fmt.Errorf("duplicate schedule ID %d", schedule.ID)
+		}
+		seen[schedule.ID] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, schedule := range data.Schedules {
+		if err := schedule.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
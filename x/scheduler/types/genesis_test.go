@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	msg, err := types.NewMsgSchedule(owner, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 1, fee, 100000)
+	require.NoError(t, err)
+
+	invalid := types.NewSchedule(2, msg)
+	invalid.GasLimit = 0
+
+	tests := []struct {
+		name       string
+		genState   types.GenesisState
+		expectPass bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"with schedules", types.NewGenesisState(types.DefaultParams(), 3, []types.Schedule{types.NewSchedule(1, msg), types.NewSchedule(2, msg)}), true},
+		{"invalid params", types.NewGenesisState(types.NewParams(0, 1000, 10, time.Minute, nil), 1, nil), false},
+		{"zero next schedule ID", types.NewGenesisState(types.DefaultParams(), 0, nil), false},
+		{"schedule ID not lower than next ID", types.NewGenesisState(types.DefaultParams(), 2, []types.Schedule{types.NewSchedule(2, msg)}), false},
+		{"duplicate schedule ID", types.NewGenesisState(types.DefaultParams(), 3, []types.Schedule{types.NewSchedule(1, msg), types.NewSchedule(1, msg)}), false},
+		{"invalid schedule", types.NewGenesisState(types.DefaultParams(), 3, []types.Schedule{invalid}), false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(tc.genState)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
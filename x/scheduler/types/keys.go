@@ -0,0 +1,95 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "scheduler"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for scheduler store
+// Items are stored with the following key: values
+//
+// - 0x01<scheduleID_Bytes>: Schedule
+//
+// - 0x02<height_Bytes><scheduleID_Bytes>: scheduleID
+//
+// - 0x03<time_Bytes><scheduleID_Bytes>: scheduleID
+//
+// - 0x04<owner_Bytes><scheduleID_Bytes>: scheduleID
+//
+// - 0x05: nextScheduleID
+var (
+	SchedulesKeyPrefix     = []byte{0x01}
+	HeightQueuePrefix      = []byte{0x02}
+	TimeQueuePrefix        = []byte{0x03}
+	SchedulesByOwnerPrefix = []byte{0x04}
+	NextScheduleIDKey      = []byte{0x05}
+)
+
+// GetScheduleIDBytes returns the byte representation of the scheduleID
+func GetScheduleIDBytes(scheduleID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, scheduleID)
+	return bz
+}
+
+// GetScheduleIDFromBytes returns scheduleID in uint64 format from a byte array
+func GetScheduleIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ScheduleKey returns the key of the schedule of the given ID.
+func ScheduleKey(scheduleID uint64) []byte {
+	return append(SchedulesKeyPrefix, GetScheduleIDBytes(scheduleID)...)
+}
+
+// HeightQueueByHeightKey returns the prefix of the keys of the schedules due at
+// the given height in the height queue.
+func HeightQueueByHeightKey(height int64) []byte {
+	return append(HeightQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// HeightQueueKey returns the key of a schedule due at the given height in the
+// height queue.
+func HeightQueueKey(scheduleID uint64, height int64) []byte {
+	return append(HeightQueueByHeightKey(height), GetScheduleIDBytes(scheduleID)...)
+}
+
+// TimeQueueByTimeKey returns the prefix of the keys of the schedules due at the
+// given time in the time queue.
+func TimeQueueByTimeKey(t time.Time) []byte {
+	return append(TimeQueuePrefix, sdk.FormatTimeBytes(t)...)
+}
+
+// TimeQueueKey returns the key of a schedule due at the given time in the time
+// queue.
+func TimeQueueKey(scheduleID uint64, t time.Time) []byte {
+	return append(TimeQueueByTimeKey(t), GetScheduleIDBytes(scheduleID)...)
+}
+
+// SchedulesByOwnerKey returns the prefix of the keys of the schedules of the
+// given owner.
+func SchedulesByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(SchedulesByOwnerPrefix, owner.Bytes()...)
+}
+
+// ScheduleByOwnerKey returns the key of a schedule in the index of the schedules
+// of its owner.
+func ScheduleByOwnerKey(owner sdk.AccAddress, scheduleID uint64) []byte {
+	return append(SchedulesByOwnerKey(owner), GetScheduleIDBytes(scheduleID)...)
+}
@@ -0,0 +1,276 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// scheduler message types
+const (
+	TypeMsgSchedule       = "schedule"
+	TypeMsgCancelSchedule = "cancel_schedule"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg                            = &MsgSchedule{}
+	_ sdk.Msg                            = &MsgCancelSchedule{}
+	_ codectypes.UnpackInterfacesMessage = MsgSchedule{}
+)
+
+// NewMsgSchedule creates a new MsgSchedule object. The first execution happens
+// at startHeight if it is positive, or else from startTime.
+func NewMsgSchedule(
+	owner sdk.AccAddress, msgs []sdk.Msg, startHeight int64, startTime *time.Time,
+	heightInterval int64, timeInterval time.Duration, executions uint64, fee sdk.Coins, gasLimit uint64,
+) (*MsgSchedule, error) {
+	anys, err := PackMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSchedule{
+		Owner:          owner,
+		Msgs:           anys,
+		StartHeight:    startHeight,
+		StartTime:      startTime,
+		HeightInterval: heightInterval,
+		TimeInterval:   timeInterval,
+		Executions:     executions,
+		Fee:            fee,
+		GasLimit:       gasLimit,
+	}, nil
+}
+
+func (msg MsgSchedule) Route() string { return RouterKey }
+func (msg MsgSchedule) Type() string  { return TypeMsgSchedule }
+
+// GetSigners returns the address of the owner of the schedule.
+func (msg MsgSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgSchedule. The scheduled
+// messages are embedded with their own sign bytes, as the module codec does not
+// know their concrete types.
+func (msg MsgSchedule) GetSignBytes() []byte {
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBytes := make([]json.RawMessage, len(msgs))
+	for i, m := range msgs {
+		msgsBytes[i] = m.GetSignBytes()
+	}
+
+	bz, err := json.Marshal(struct {
+		Owner          sdk.AccAddress    `json:"owner"`
+		Msgs           []json.RawMessage `json:"msgs"`
+		StartHeight    int64             `json:"start_height"`
+		StartTime      *time.Time        `json:"start_time"`
+		HeightInterval int64             `json:"height_interval"`
+		TimeInterval   time.Duration     `json:"time_interval"`
+		Executions     uint64            `json:"executions"`
+		Fee            sdk.Coins         `json:"fee"`
+		GasLimit       uint64            `json:"gas_limit"`
+	}{
+		msg.Owner, msgsBytes, msg.StartHeight, msg.StartTime, msg.HeightInterval, msg.TimeInterval,
+		msg.Executions, msg.Fee, msg.GasLimit,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs a stateless validation of the message.
+func (msg MsgSchedule) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner address")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	if err := ValidateMsgs(msg.Owner, msgs); err != nil {
+		return err
+	}
+
+	if err := ValidateTrigger(msg.StartHeight, msg.StartTime, msg.HeightInterval, msg.TimeInterval, msg.Executions); err != nil {
+		return err
+	}
+
+	return validateExecution(msg.Fee, msg.GasLimit)
+}
+
+// GetMessages returns the cached values of the scheduled messages.
+func (msg MsgSchedule) GetMessages() ([]sdk.Msg, error) {
+	return MsgsFromAnys(msg.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSchedule) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return UnpackMsgs(unpacker, msg.Msgs)
+}
+
+// NewMsgCancelSchedule creates a new MsgCancelSchedule object
+func NewMsgCancelSchedule(owner sdk.AccAddress, scheduleID uint64) *MsgCancelSchedule {
+	return &MsgCancelSchedule{
+		Owner:      owner,
+		ScheduleID: scheduleID,
+	}
+}
+
+func (msg MsgCancelSchedule) Route() string { return RouterKey }
+func (msg MsgCancelSchedule) Type() string  { return TypeMsgCancelSchedule }
+
+// GetSigners returns the address of the owner of the schedule.
+func (msg MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgCancelSchedule
+func (msg MsgCancelSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs a stateless validation of the message.
+func (msg MsgCancelSchedule) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner address")
+	}
+
+	return nil
+}
+
+// ValidateMsgs checks that the given messages can be scheduled on behalf of the
+// given owner: they must be valid, signed by the owner only, and must not be
+// scheduler messages.
+func ValidateMsgs(owner sdk.AccAddress, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgs, "no message")
+	}
+
+	for i, msg := range msgs {
+		if msg.Route() == RouterKey {
+			return sdkerrors.Wrapf(ErrInvalidMsgs, "message %d: scheduler messages cannot be scheduled", i)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(owner) {
+				return sdkerrors.Wrapf(ErrInvalidMsgs, "message %d: signer %s is not the owner", i, signer)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateTrigger checks that a schedule is triggered either by a positive
+// height or by a time, and that a recurring schedule has a positive interval
+// of the same kind.
+func ValidateTrigger(height int64, t *time.Time, heightInterval int64, timeInterval time.Duration, executions uint64) error {
+	if executions == 0 {
+		return sdkerrors.Wrap(ErrInvalidExecutions, "at least one execution is required")
+	}
+	if heightInterval < 0 || timeInterval < 0 {
+		return sdkerrors.Wrap(ErrInvalidTrigger, "intervals cannot be negative")
+	}
+
+	switch {
+	case height < 0:
+		return sdkerrors.Wrapf(ErrInvalidTrigger, "negative height %d", height)
+
+	case height > 0 && t != nil:
+		return sdkerrors.Wrap(ErrInvalidTrigger, "either a height or a time is required, not both")
+
+	case height > 0:
+		if timeInterval != 0 {
+			return sdkerrors.Wrap(ErrInvalidTrigger, "a height based schedule cannot have a time interval")
+		}
+		if executions > 1 && heightInterval == 0 {
+			return sdkerrors.Wrap(ErrInvalidTrigger, "a recurring height based schedule requires a height interval")
+		}
+
+	case t != nil:
+		if heightInterval != 0 {
+			return sdkerrors.Wrap(ErrInvalidTrigger, "a time based schedule cannot have a height interval")
+		}
+		if executions > 1 && timeInterval == 0 {
+			return sdkerrors.Wrap(ErrInvalidTrigger, "a recurring time based schedule requires a time interval")
+		}
+
+	default:
+		return sdkerrors.Wrap(ErrInvalidTrigger, "either a height or a time is required")
+	}
+
+	return nil
+}
+
+func validateExecution(fee sdk.Coins, gasLimit uint64) error {
+	if !fee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fee.String())
+	}
+	if fee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, "fee must be positive")
+	}
+	if gasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidGasLimit, "gas limit must be positive")
+	}
+
+	return nil
+}
+
+// PackMsgs packs the given messages into Anys.
+func PackMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+// MsgsFromAnys returns the cached values of the given Anys, which must have been
+// unpacked into messages.
+func MsgsFromAnys(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		if any == nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidMsgs, "message %d is empty", i)
+		}
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidMsgs, "message %d: cannot unpack %s into sdk.Msg", i, any.GetTypeUrl())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// UnpackMsgs unpacks the given Anys into messages.
+func UnpackMsgs(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
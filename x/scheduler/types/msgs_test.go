@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+var (
+	owner = sdk.AccAddress([]byte("owner_______________"))
+	other = sdk.AccAddress([]byte("other_______________"))
+	fee   = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+)
+
+func newSend(from sdk.AccAddress) sdk.Msg {
+	return banktypes.NewMsgSend(from, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+}
+
+func TestMsgScheduleValidateBasic(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cancel := types.NewMsgCancelSchedule(owner, 1)
+
+	tests := []struct {
+		name           string
+		owner          sdk.AccAddress
+		msgs           []sdk.Msg
+		startHeight    int64
+		startTime      *time.Time
+		heightInterval int64
+		timeInterval   time.Duration
+		executions     uint64
+		fee            sdk.Coins
+		gasLimit       uint64
+		expectPass     bool
+	}{
+		{"one-shot at height", owner, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 1, fee, 100000, true},
+		{"one-shot at time", owner, []sdk.Msg{newSend(owner)}, 0, &start, 0, 0, 1, fee, 100000, true},
+		{"recurring by height", owner, []sdk.Msg{newSend(owner)}, 10, nil, 5, 0, 3, fee, 100000, true},
+		{"recurring by time", owner, []sdk.Msg{newSend(owner)}, 0, &start, 0, time.Hour, 3, fee, 100000, true},
+		{"no owner", nil, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 1, fee, 100000, false},
+		{"no message", owner, nil, 10, nil, 0, 0, 1, fee, 100000, false},
+		{"invalid message", owner, []sdk.Msg{banktypes.NewMsgSend(owner, other, nil)}, 10, nil, 0, 0, 1, fee, 100000, false},
+		{"message of another signer", owner, []sdk.Msg{newSend(other)}, 10, nil, 0, 0, 1, fee, 100000, false},
+		{"scheduler message", owner, []sdk.Msg{cancel}, 10, nil, 0, 0, 1, fee, 100000, false},
+		{"no trigger", owner, []sdk.Msg{newSend(owner)}, 0, nil, 0, 0, 1, fee, 100000, false},
+		{"height and time", owner, []sdk.Msg{newSend(owner)}, 10, &start, 0, 0, 1, fee, 100000, false},
+		{"negative height", owner, []sdk.Msg{newSend(owner)}, -1, nil, 0, 0, 1, fee, 100000, false},
+		{"no execution", owner, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 0, fee, 100000, false},
+		{"recurring without interval", owner, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 2, fee, 100000, false},
+		{"height based with time interval", owner, []sdk.Msg{newSend(owner)}, 10, nil, 5, time.Hour, 2, fee, 100000, false},
+		{"time based with height interval", owner, []sdk.Msg{newSend(owner)}, 0, &start, 5, time.Hour, 2, fee, 100000, false},
+		{"negative interval", owner, []sdk.Msg{newSend(owner)}, 10, nil, -5, 0, 2, fee, 100000, false},
+		{"invalid fee", owner, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 1, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}, 100000, false},
+		{"no fee", owner, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 1, nil, 100000, false},
+		{"no gas", owner, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 1, fee, 0, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := types.NewMsgSchedule(
+				tc.owner, tc.msgs, tc.startHeight, tc.startTime, tc.heightInterval, tc.timeInterval,
+				tc.executions, tc.fee, tc.gasLimit,
+			)
+			require.NoError(t, err)
+
+			if tc.expectPass {
+				require.NoError(t, msg.ValidateBasic())
+				require.NotPanics(t, func() { msg.GetSignBytes() })
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgScheduleGetSignBytes(t *testing.T) {
+	msg, err := types.NewMsgSchedule(owner, []sdk.Msg{newSend(owner)}, 10, nil, 0, 0, 1, fee, 100000)
+	require.NoError(t, err)
+
+	// the scheduled messages are signed over with their own sign bytes
+	require.Contains(t, string(msg.GetSignBytes()), string(newSend(owner).GetSignBytes()))
+	require.Equal(t, []sdk.AccAddress{owner}, msg.GetSigners())
+}
+
+func TestMsgCancelScheduleValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgCancelSchedule(owner, 1).ValidateBasic())
+	require.Error(t, types.NewMsgCancelSchedule(nil, 1).ValidateBasic())
+}
+
+func TestScheduleAdvance(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	msg, err := types.NewMsgSchedule(owner, []sdk.Msg{newSend(owner)}, 10, nil, 5, 0, 3, fee, 100000)
+	require.NoError(t, err)
+	schedule := types.NewSchedule(1, msg)
+	require.True(t, schedule.IsHeightBased())
+	require.False(t, schedule.IsDue(9, start))
+	require.True(t, schedule.IsDue(10, start))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), schedule.Escrow())
+
+	schedule.Advance()
+	require.Equal(t, int64(15), schedule.NextHeight)
+
+	msg, err = types.NewMsgSchedule(owner, []sdk.Msg{newSend(owner)}, 0, &start, 0, time.Hour, 3, fee, 100000)
+	require.NoError(t, err)
+	schedule = types.NewSchedule(2, msg)
+	require.False(t, schedule.IsHeightBased())
+	require.False(t, schedule.IsDue(100, start.Add(-time.Second)))
+	require.True(t, schedule.IsDue(100, start))
+
+	schedule.Advance()
+	require.Equal(t, start.Add(time.Hour), *schedule.NextTime)
+
+	schedule.RemainingExecutions = 0
+	require.True(t, schedule.Escrow().IsZero())
+}
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultMaxExecutionsPerBlock uint32 = 100
+	DefaultMaxGasPerExecution    uint64 = 1000000
+	DefaultMaxExecutions         uint64 = 1000
+	DefaultMinTimeInterval              = time.Minute
+)
+
+// DefaultMinGasPrices is the default value of the minimum gas prices of an
+// execution, i.e. none.
+var DefaultMinGasPrices sdk.DecCoins
+
+// Parameter keys
+var (
+	KeyMaxExecutionsPerBlock = []byte("MaxExecutionsPerBlock")
+	KeyMaxGasPerExecution    = []byte("MaxGasPerExecution")
+	KeyMaxExecutions         = []byte("MaxExecutions")
+	KeyMinTimeInterval       = []byte("MinTimeInterval")
+	KeyMinGasPrices          = []byte("MinGasPrices")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// NewParams creates a new Params object
+func NewParams(
+	maxExecutionsPerBlock uint32, maxGasPerExecution, maxExecutions uint64, minTimeInterval time.Duration,
+	minGasPrices sdk.DecCoins,
+) Params {
+	return Params{
+		MaxExecutionsPerBlock: maxExecutionsPerBlock,
+		MaxGasPerExecution:    maxGasPerExecution,
+		MaxExecutions:         maxExecutions,
+		MinTimeInterval:       minTimeInterval,
+		MinGasPrices:          minGasPrices,
+	}
+}
+
+// DefaultParams returns default scheduler parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxExecutionsPerBlock, DefaultMaxGasPerExecution, DefaultMaxExecutions, DefaultMinTimeInterval,
+		DefaultMinGasPrices,
+	)
+}
+
+// ParamKeyTable returns the parameter key table of the scheduler module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of the scheduler module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxExecutionsPerBlock, &p.MaxExecutionsPerBlock, validateMaxExecutionsPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxGasPerExecution, &p.MaxGasPerExecution, validateMaxGasPerExecution),
+		paramtypes.NewParamSetPair(KeyMaxExecutions, &p.MaxExecutions, validateMaxExecutions),
+		paramtypes.NewParamSetPair(KeyMinTimeInterval, &p.MinTimeInterval, validateMinTimeInterval),
+		paramtypes.NewParamSetPair(KeyMinGasPrices, &p.MinGasPrices, validateMinGasPrices),
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateMaxExecutionsPerBlock(p.MaxExecutionsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateMaxGasPerExecution(p.MaxGasPerExecution); err != nil {
+		return err
+	}
+	if err := validateMaxExecutions(p.MaxExecutions); err != nil {
+		return err
+	}
+	if err := validateMinTimeInterval(p.MinTimeInterval); err != nil {
+		return err
+	}
+
+	return validateMinGasPrices(p.MinGasPrices)
+}
+
+// MinFee returns the minimum fee of an execution with the given gas limit, i.e.
+// its gas limit priced at the minimum gas prices, rounded up.
+func (p Params) MinFee(gasLimit uint64) sdk.Coins {
+	minFee := make(sdk.Coins, len(p.MinGasPrices))
+
+	gas := sdk.NewDec(int64(gasLimit))
+	for i, gp := range p.MinGasPrices {
+		minFee[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(gas).Ceil().RoundInt())
+	}
+
+	return minFee
+}
+
+func validateMaxExecutionsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max executions per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxGasPerExecution(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max gas per execution must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxExecutions(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max executions must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinTimeInterval(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("min time interval must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid min gas prices: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = QueryScheduleResponse{}
+	_ codectypes.UnpackInterfacesMessage = QuerySchedulesResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryScheduleResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return res.Schedule.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QuerySchedulesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, schedule := range res.Schedules {
+		if err := schedule.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = Schedule{}
+
+// NewSchedule creates the schedule of the given ID from a MsgSchedule.
+func NewSchedule(id uint64, msg *MsgSchedule) Schedule {
+	return Schedule{
+		ID:                  id,
+		Owner:               msg.Owner,
+		Msgs:                msg.Msgs,
+		NextHeight:          msg.StartHeight,
+		NextTime:            msg.StartTime,
+		HeightInterval:      msg.HeightInterval,
+		TimeInterval:        msg.TimeInterval,
+		RemainingExecutions: msg.Executions,
+		Fee:                 msg.Fee,
+		GasLimit:            msg.GasLimit,
+	}
+}
+
+// String implements the Stringer interface.
+func (s Schedule) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// IsHeightBased returns true if the schedule is triggered by the block height,
+// or false if it is triggered by the block time.
+func (s Schedule) IsHeightBased() bool {
+	return s.NextHeight > 0
+}
+
+// IsDue returns true if the next execution of the schedule is due at the given
+// block height and time.
+func (s Schedule) IsDue(height int64, t time.Time) bool {
+	if s.IsHeightBased() {
+		return s.NextHeight <= height
+	}
+
+	return !s.NextTime.After(t)
+}
+
+// Advance moves the next execution of a recurring schedule by its interval.
+func (s *Schedule) Advance() {
+	if s.IsHeightBased() {
+		s.NextHeight += s.HeightInterval
+		return
+	}
+
+	next := s.NextTime.Add(s.TimeInterval)
+	s.NextTime = &next
+}
+
+// Escrow returns the fee held in escrow for the remaining executions.
+func (s Schedule) Escrow() sdk.Coins {
+	return MulCoins(s.Fee, s.RemainingExecutions)
+}
+
+// GetMessages returns the cached values of the scheduled messages.
+func (s Schedule) GetMessages() ([]sdk.Msg, error) {
+	return MsgsFromAnys(s.Msgs)
+}
+
+// Validate performs a stateless validation of the schedule.
+func (s Schedule) Validate() error {
+	if s.ID == 0 {
+		return sdkerrors.Wrap(ErrUnknownSchedule, "schedule ID must be positive")
+	}
+	if s.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner address")
+	}
+
+	msgs, err := s.GetMessages()
+	if err != nil {
+		return err
+	}
+	if err := ValidateMsgs(s.Owner, msgs); err != nil {
+		return err
+	}
+
+	if err := ValidateTrigger(s.NextHeight, s.NextTime, s.HeightInterval, s.TimeInterval, s.RemainingExecutions); err != nil {
+		return err
+	}
+
+	return validateExecution(s.Fee, s.GasLimit)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s Schedule) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return UnpackMsgs(unpacker, s.Msgs)
+}
+
+// MulCoins returns the given coins multiplied by n.
+func MulCoins(coins sdk.Coins, n uint64) sdk.Coins {
+	if n == 0 {
+		return sdk.NewCoins()
+	}
+
+	res := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		res[i] = sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(n)))
+	}
+
+	return res
+}
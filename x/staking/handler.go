@@ -121,6 +121,14 @@ func handleMsgCreateValidator(ctx sdk.Context, msg *types.MsgCreateValidator, k
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateValidator{
+		Validator: msg.ValidatorAddress.String(),
+		Amount:    msg.Value.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -178,6 +186,15 @@ func handleMsgEditValidator(ctx sdk.Context, msg *types.MsgEditValidator, k keep
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEditValidator{
+		Validator:         msg.ValidatorAddress.String(),
+		CommissionRate:    validator.Commission.Rate,
+		MinSelfDelegation: validator.MinSelfDelegation,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -220,6 +237,15 @@ func handleMsgDelegate(ctx sdk.Context, msg *types.MsgDelegate, k keeper.Keeper)
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDelegate{
+		Validator: msg.ValidatorAddress.String(),
+		Delegator: msg.DelegatorAddress.String(),
+		Amount:    msg.Amount.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -270,6 +296,16 @@ func handleMsgUndelegate(ctx sdk.Context, msg *types.MsgUndelegate, k keeper.Kee
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUnbond{
+		Validator:      msg.ValidatorAddress.String(),
+		Delegator:      msg.DelegatorAddress.String(),
+		Amount:         msg.Amount.Amount,
+		CompletionTime: completionTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -323,5 +359,16 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg *types.MsgBeginRedelegate, k
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedelegate{
+		SrcValidator:   msg.ValidatorSrcAddress.String(),
+		DstValidator:   msg.ValidatorDstAddress.String(),
+		Delegator:      msg.DelegatorAddress.String(),
+		Amount:         msg.Amount.Amount,
+		CompletionTime: completionTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().ABCIEvents()}, nil
 }
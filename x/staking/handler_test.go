@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
+	"github.com/gogo/protobuf/proto"
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1505,3 +1506,61 @@ func TestInvalidCoinDenom(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func TestTypedEvents(t *testing.T) {
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 1, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	validatorAddr, delegatorAddr := valAddrs[0], sdk.AccAddress(valAddrs[0])
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = 7 * time.Second
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// parseTypedEvent returns the typed event of the given type among events
+	parseTypedEvent := func(events []abci.Event, evtType string) proto.Message {
+		for _, event := range events {
+			if event.Type == evtType {
+				tev, err := sdk.ParseTypedEvent(event)
+				require.NoError(t, err)
+				return tev
+			}
+		}
+
+		require.FailNow(t, "typed event not found", evtType)
+		return nil
+	}
+
+	// the legacy events are emitted along with the typed ones
+	valTokens := sdk.TokensFromConsensusPower(10)
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeCreateValidator, res.Events[0].Type)
+	require.Equal(t,
+		&types.EventCreateValidator{Validator: validatorAddr.String(), Amount: valTokens},
+		parseTypedEvent(res.Events, "cosmos.staking.EventCreateValidator"),
+	)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, valTokens)
+	res, err = handler(ctx, types.NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt))
+	require.NoError(t, err)
+
+	unbond := parseTypedEvent(res.Events, "cosmos.staking.EventUnbond").(*types.EventUnbond)
+	require.Equal(t, validatorAddr.String(), unbond.Validator)
+	require.Equal(t, delegatorAddr.String(), unbond.Delegator)
+	require.Equal(t, valTokens, unbond.Amount)
+	require.True(t, ctx.BlockHeader().Time.Add(params.UnbondingTime).Equal(unbond.CompletionTime))
+
+	// the unbonding completes in the end blocker
+	ctx = ctx.WithBlockTime(unbond.CompletionTime).WithEventManager(sdk.NewEventManager())
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t,
+		&types.EventCompleteUnbonding{
+			Validator: validatorAddr.String(),
+			Delegator: delegatorAddr.String(),
+			Amount:    sdk.NewCoins(unbondAmt),
+		},
+		parseTypedEvent(ctx.EventManager().ABCIEvents(), "cosmos.staking.EventCompleteUnbonding"),
+	)
+}
@@ -45,6 +45,15 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 				sdk.NewAttribute(types.AttributeKeyDelegator, dvPair.DelegatorAddress.String()),
 			),
 		)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCompleteUnbonding{
+			Validator: dvPair.ValidatorAddress.String(),
+			Delegator: dvPair.DelegatorAddress.String(),
+			Amount:    balances,
+		})
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	// Remove all mature redelegations from the red queue.
@@ -69,6 +78,16 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 				sdk.NewAttribute(types.AttributeKeyDstValidator, dvvTriplet.ValidatorDstAddress.String()),
 			),
 		)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCompleteRedelegation{
+			SrcValidator: dvvTriplet.ValidatorSrcAddress.String(),
+			DstValidator: dvvTriplet.ValidatorDstAddress.String(),
+			Delegator:    dvvTriplet.DelegatorAddress.String(),
+			Amount:       balances,
+		})
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	return validatorUpdates